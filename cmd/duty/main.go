@@ -45,16 +45,17 @@ func main() {
 			},
 		},
 		&cobra.Command{
-			Use:   "bind-checkpoint-key [signer] [checkpoint-pub-key] [binding-signature] [consensus-address]",
+			Use:   "bind-checkpoint-key [signer] [checkpoint-pub-key] [binding-signature] [consensus-address] [consensus-signature]",
 			Short: "Bind checkpoint key to consensus validator",
 			Long:  "Create a canonical binding between consensus validator and checkpoint key",
-			Args:  cobra.ExactArgs(4),
+			Args:  cobra.ExactArgs(5),
 			Run: func(cmd *cobra.Command, args []string) {
 				fmt.Printf("Binding checkpoint key:\n")
 				fmt.Printf("  Signer: %s\n", args[0])
 				fmt.Printf("  Checkpoint Pub Key: %s\n", args[1])
 				fmt.Printf("  Binding Signature: %s\n", args[2])
 				fmt.Printf("  Consensus Address: %s\n", args[3])
+				fmt.Printf("  Consensus Signature: %s\n", args[4])
 			},
 		},
 	)
@@ -0,0 +1,19 @@
+#!/usr/bin/env bash
+
+# Regenerates the Go code for proto/. Requires buf and protoc-gen-gocosmos
+# (go install github.com/cosmos/gogoproto/protoc-gen-gocosmos) on PATH.
+
+set -eo pipefail
+
+cd "$(dirname "$0")/../proto"
+
+echo "Generating gogo proto code"
+buf generate --template buf.gen.gogo.yaml --path duty/v1
+
+echo "Generating module config code"
+buf generate --template buf.gen.module.yaml --path duty/module/v1
+
+cd ..
+
+cp -r github.com/TheArticulation/Duty/* ./
+rm -rf github.com
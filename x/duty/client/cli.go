@@ -1,6 +1,11 @@
 package client
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
 	"github.com/TheArticulation/Duty/x/duty/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -22,17 +27,27 @@ func GetTxCmd() *cobra.Command {
 		GetCmdSetDutyMetadata(),
 		GetCmdRotateCheckpointKey(),
 		GetCmdBindCheckpointKey(),
+		GetCmdSubmitCheckpointSignature(),
+		GetCmdSubmitCheckpointEquivocation(),
 	)
 
 	return cmd
 }
 
+const (
+	// FlagAnnouncement takes a storage announcement as <origin-domain>:<signature>
+	FlagAnnouncement = "announcement"
+	// FlagNonce is the validator's current duty nonce, see `query duty duty-nonce`
+	FlagNonce = "nonce"
+)
+
 // GetCmdSetDutyMetadata returns the command to set duty metadata
 func GetCmdSetDutyMetadata() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set-duty-metadata [signer] [checkpoint-pub-key] [checkpoint-storage-uri]",
-		Short: "Set duty metadata for a validator",
-		Args:  cobra.ExactArgs(3),
+		Use:   "set-duty-metadata [signer] [checkpoint-storage-uri]",
+		Short: "Set the checkpoint storage location of a validator",
+		Long:  "Set the checkpoint storage location of a validator. Checkpoint keys are set with bind-checkpoint-key and rotate-checkpoint-key.",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -40,21 +55,38 @@ func GetCmdSetDutyMetadata() *cobra.Command {
 			}
 
 			signer := args[0]
-			checkpointPubKey := args[1]
-			checkpointStorageURI := args[2]
+			checkpointStorageURI := args[1]
+
+			nonce, _ := cmd.Flags().GetUint64(FlagNonce)
+			announcementFlags, _ := cmd.Flags().GetStringArray(FlagAnnouncement)
+			announcements := make([]*types.ValidatorAnnouncement, 0, len(announcementFlags))
+			for _, a := range announcementFlags {
+				domain, sig, ok := strings.Cut(a, ":")
+				if !ok {
+					return fmt.Errorf("invalid --%s %q: expected <origin-domain>:<signature>", FlagAnnouncement, a)
+				}
+				originDomain, err := strconv.ParseUint(domain, 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid --%s %q: %w", FlagAnnouncement, a, err)
+				}
+				announcements = append(announcements, &types.ValidatorAnnouncement{OriginDomain: uint32(originDomain), Signature: sig})
+			}
 
 			msg := &types.MsgSetDutyMetadata{
 				Signer: signer,
-				Metadata: &types.DutyMetadata{
-					CheckpointPubKey:     checkpointPubKey,
+				Metadata: types.DutyMetadata{
 					CheckpointStorageUri: checkpointStorageURI,
 				},
+				Announcements: announcements,
+				Nonce:         nonce,
 			}
 
 			return clientCtx.PrintProto(msg)
 		},
 	}
 
+	cmd.Flags().StringArray(FlagAnnouncement, nil, "ValidatorAnnounce signature for an origin domain as <origin-domain>:<signature>; repeatable")
+	cmd.Flags().Uint64(FlagNonce, 0, "Current duty nonce of the validator")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -74,17 +106,20 @@ func GetCmdRotateCheckpointKey() *cobra.Command {
 			signer := args[0]
 			newCheckpointPubKey := args[1]
 			attestationSignature := args[2]
+			nonce, _ := cmd.Flags().GetUint64(FlagNonce)
 
 			msg := &types.MsgRotateCheckpointKey{
 				Signer:               signer,
 				NewCheckpointPubKey:  newCheckpointPubKey,
 				AttestationSignature: attestationSignature,
+				Nonce:                nonce,
 			}
 
 			return clientCtx.PrintProto(msg)
 		},
 	}
 
+	cmd.Flags().Uint64(FlagNonce, 0, "Current duty nonce of the validator, as signed in the attestation")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -92,9 +127,9 @@ func GetCmdRotateCheckpointKey() *cobra.Command {
 // GetCmdBindCheckpointKey returns the command to bind checkpoint key
 func GetCmdBindCheckpointKey() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bind-checkpoint-key [signer] [checkpoint-pub-key] [binding-signature] [consensus-address]",
+		Use:   "bind-checkpoint-key [signer] [checkpoint-pub-key] [binding-signature] [consensus-address] [consensus-signature]",
 		Short: "Bind checkpoint key to consensus validator",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -105,13 +140,92 @@ func GetCmdBindCheckpointKey() *cobra.Command {
 			checkpointPubKey := args[1]
 			bindingSignature := args[2]
 			consensusAddress := args[3]
+			consensusSignature := args[4]
+			nonce, _ := cmd.Flags().GetUint64(FlagNonce)
+			originDomain, _ := cmd.Flags().GetUint32(FlagOriginDomain)
 
 			msg := &types.MsgBindCheckpointKey{
-				Signer:           signer,
-				CheckpointPubKey: checkpointPubKey,
-				BindingSignature: bindingSignature,
-				ConsensusAddress: consensusAddress,
+				Signer:             signer,
+				CheckpointPubKey:   checkpointPubKey,
+				BindingSignature:   bindingSignature,
+				ConsensusAddress:   consensusAddress,
+				ConsensusSignature: consensusSignature,
+				Nonce:              nonce,
+				OriginDomain:       originDomain,
+			}
+
+			return clientCtx.PrintProto(msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagNonce, 0, "Current duty nonce of the validator, as signed in both binding signatures")
+	cmd.Flags().Uint32(FlagOriginDomain, 0, "Bind the key for this registered origin domain only, as signed in both binding signatures; 0 binds the default key")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitCheckpointSignature returns the command to submit a checkpoint signature
+func GetCmdSubmitCheckpointSignature() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-checkpoint-signature [signer] [origin-domain] [merkle-tree-hook] [root] [index] [message-id] [signature]",
+		Short: "Submit a validator signature over a Hyperlane checkpoint",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			originDomain, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			index, err := strconv.ParseUint(args[4], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitCheckpointSignature{
+				Signer:         args[0],
+				OriginDomain:   uint32(originDomain),
+				MerkleTreeHook: args[2],
+				Root:           args[3],
+				Index:          uint32(index),
+				MessageId:      args[5],
+				Signature:      args[6],
+			}
+
+			return clientCtx.PrintProto(msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitCheckpointEquivocation returns the command to submit checkpoint equivocation evidence
+func GetCmdSubmitCheckpointEquivocation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-checkpoint-equivocation [submitter] [evidence-file]",
+		Short: "Submit two conflicting checkpoints signed by the same checkpoint key",
+		Long:  "Submit equivocation evidence. The evidence file is JSON with \"first\" and \"second\" signed checkpoints.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitCheckpointEquivocation{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return err
 			}
+			msg.Submitter = args[0]
 
 			return clientCtx.PrintProto(msg)
 		},
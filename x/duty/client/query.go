@@ -1,10 +1,14 @@
 package client
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/TheArticulation/Duty/x/duty/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
@@ -20,17 +24,48 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdDutySet(),
+		GetCmdPendingDutySet(),
 		GetCmdDutyMetadata(),
+		GetCmdDutyValidator(),
+		GetCmdDutyValidatorByCheckpointKey(),
+		GetCmdDutySetAtHeight(),
+		GetCmdDutySetByEpoch(),
+		GetCmdCheckpoint(),
+		GetCmdCheckpointSigningInfo(),
+		GetCmdDutySetHealth(),
+		GetCmdOriginDomain(),
+		GetCmdOriginDomains(),
+		GetCmdAnnouncedStorageLocations(),
+		GetCmdCheckpointKeys(),
+		GetCmdDutyNonce(),
+		GetCmdConsensusKeyMigration(),
+		GetCmdArchivedDutyMetadata(),
+		GetCmdArchivedDutyMetadataByCheckpointKey(),
 	)
 
 	return cmd
 }
 
+const (
+	FlagOnlyWithMetadata = "only-with-metadata"
+	FlagMissingMetadata  = "missing-metadata"
+	FlagMinVotingPower   = "min-voting-power"
+	FlagOrder            = "order"
+	FlagOriginDomain     = "origin-domain"
+)
+
+// dutySetOrders maps --order values to DutySetOrder
+var dutySetOrders = map[string]types.DutySetOrder{
+	"power-desc": types.DutySetOrder_DUTY_SET_ORDER_POWER_DESC,
+	"power-asc":  types.DutySetOrder_DUTY_SET_ORDER_POWER_ASC,
+	"cons-addr":  types.DutySetOrder_DUTY_SET_ORDER_CONS_ADDR,
+}
+
 // GetCmdDutySet returns the command to query the duty set
 func GetCmdDutySet() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "duty-set",
-		Short: "Query the current duty set",
+		Short: "Query the active duty set for the current epoch",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -38,8 +73,62 @@ func GetCmdDutySet() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			onlyWithMetadata, _ := cmd.Flags().GetBool(FlagOnlyWithMetadata)
+			missingMetadata, _ := cmd.Flags().GetBool(FlagMissingMetadata)
+			minVotingPower, _ := cmd.Flags().GetString(FlagMinVotingPower)
+			originDomain, _ := cmd.Flags().GetUint32(FlagOriginDomain)
+			orderFlag, _ := cmd.Flags().GetString(FlagOrder)
+			order, ok := dutySetOrders[orderFlag]
+			if !ok {
+				return fmt.Errorf("invalid --%s %q: expected power-desc, power-asc or cons-addr", FlagOrder, orderFlag)
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.DutySet(cmd.Context(), &types.QueryDutySetRequest{})
+			res, err := queryClient.DutySet(cmd.Context(), &types.QueryDutySetRequest{
+				Pagination:       pageReq,
+				OnlyWithMetadata: onlyWithMetadata,
+				MissingMetadata:  missingMetadata,
+				MinVotingPower:   minVotingPower,
+				Order:            order,
+				OriginDomain:     originDomain,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagOnlyWithMetadata, false, "Only return validators that registered a checkpoint key")
+	cmd.Flags().Bool(FlagMissingMetadata, false, "Only return validators without a checkpoint key")
+	cmd.Flags().String(FlagMinVotingPower, "", "Only return validators with at least this consensus power")
+	cmd.Flags().String(FlagOrder, "power-desc", "Order of the results: power-desc, power-asc or cons-addr")
+	cmd.Flags().Uint32(FlagOriginDomain, 0, "Resolve checkpoint keys, storage URIs and quorum for this origin domain")
+	cmd.MarkFlagsMutuallyExclusive(FlagOnlyWithMetadata, FlagMissingMetadata)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "duty-set")
+	return cmd
+}
+
+// GetCmdPendingDutySet returns the command to query the pending duty set
+func GetCmdPendingDutySet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-duty-set",
+		Short: "Query the duty set that becomes active at the next epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingDutySet(cmd.Context(), &types.QueryPendingDutySetRequest{})
 			if err != nil {
 				return err
 			}
@@ -55,8 +144,8 @@ func GetCmdDutySet() *cobra.Command {
 // GetCmdDutyMetadata returns the command to query duty metadata
 func GetCmdDutyMetadata() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "duty-metadata [consensus-address]",
-		Short: "Query duty metadata for a validator",
+		Use:   "duty-metadata [operator-or-consensus-address]",
+		Short: "Query duty metadata for a validator by operator (valoper) or consensus (valcons) address",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -64,10 +153,475 @@ func GetCmdDutyMetadata() *cobra.Command {
 				return err
 			}
 
-			consensusAddress := args[0]
 			queryClient := types.NewQueryClient(clientCtx)
+			if _, err := sdk.ValAddressFromBech32(args[0]); err == nil {
+				res, err := queryClient.DutyMetadataByOperator(cmd.Context(), &types.QueryDutyMetadataByOperatorRequest{
+					ValAddr: args[0],
+				})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
 			res, err := queryClient.DutyMetadata(cmd.Context(), &types.QueryDutyMetadataRequest{
-				ConsAddr: consensusAddress,
+				ConsAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDutyValidator returns the command to query a validator's staking and duty state
+func GetCmdDutyValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "duty-validator [operator-or-consensus-address]",
+		Short: "Query a validator's addresses, moniker, status, power and duty metadata",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DutyValidator(cmd.Context(), &types.QueryDutyValidatorRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDutyValidatorByCheckpointKey returns the command to look up a validator by checkpoint key
+func GetCmdDutyValidatorByCheckpointKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-by-checkpoint-key [checkpoint-key-or-address]",
+		Short: "Query the validator that registered a checkpoint key or signer address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DutyValidatorByCheckpointKey(cmd.Context(), &types.QueryDutyValidatorByCheckpointKeyRequest{
+				CheckpointKey: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDutySetAtHeight returns the command to query the duty set in effect at a height
+func GetCmdDutySetAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "duty-set-at-height [height]",
+		Short: "Query the duty set snapshot in effect at a block height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DutySetAtHeight(cmd.Context(), &types.QueryDutySetAtHeightRequest{
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDutySetByEpoch returns the command to query the duty set committed for an epoch
+func GetCmdDutySetByEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "duty-set-by-epoch [epoch]",
+		Short: "Query the duty set snapshot committed for an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DutySetByEpoch(cmd.Context(), &types.QueryDutySetByEpochRequest{
+				Epoch: epoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCheckpoint returns the command to query aggregated checkpoint signatures
+func GetCmdCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint [origin-domain] [index]",
+		Short: "Query the aggregated signatures for a Hyperlane checkpoint",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			originDomain, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			index, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Checkpoint(cmd.Context(), &types.QueryCheckpointRequest{
+				OriginDomain: uint32(originDomain),
+				Index:        uint32(index),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCheckpointSigningInfo returns the command to query a validator's checkpoint liveness
+func GetCmdCheckpointSigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-signing-info [consensus-address]",
+		Short: "Query a validator's checkpoint signing liveness",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CheckpointSigningInfo(cmd.Context(), &types.QueryCheckpointSigningInfoRequest{
+				ConsAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDutySetHealth returns the command to query duty set quorum coverage
+func GetCmdDutySetHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "duty-set-health",
+		Short: "Query whether validators with valid duty metadata can reach quorum",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DutySetHealth(cmd.Context(), &types.QueryDutySetHealthRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdOriginDomain returns the command to query a registered origin domain
+func GetCmdOriginDomain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "origin-domain [domain-id]",
+		Short: "Query a registered origin domain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			domainID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OriginDomain(cmd.Context(), &types.QueryOriginDomainRequest{
+				DomainId: uint32(domainID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdOriginDomains returns the command to list registered origin domains
+func GetCmdOriginDomains() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "origin-domains",
+		Short: "Query all registered origin domains",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OriginDomains(cmd.Context(), &types.QueryOriginDomainsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "origin-domains")
+	return cmd
+}
+
+// GetCmdAnnouncedStorageLocations returns the command to query announced checkpoint storage locations
+func GetCmdAnnouncedStorageLocations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "announced-storage-locations [origin-domain] [validator-address]...",
+		Short: "Query the storage locations checkpoint addresses announced for an origin domain",
+		Long:  "Query the storage locations checkpoint addresses announced for an origin domain. The result has one list per validator address, in the order given, like ValidatorAnnounce.getAnnouncedStorageLocations.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			originDomain, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AnnouncedStorageLocations(cmd.Context(), &types.QueryAnnouncedStorageLocationsRequest{
+				OriginDomain: uint32(originDomain),
+				Validators:   args[1:],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCheckpointKeys returns the command to query a validator's checkpoint key rotation state
+func GetCmdCheckpointKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-keys [consensus-address]",
+		Short: "Query the current, pending and previous checkpoint keys of a validator with their validity ranges",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CheckpointKeys(cmd.Context(), &types.QueryCheckpointKeysRequest{
+				ConsAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDutyNonce returns the command to query the duty nonce of a validator
+func GetCmdDutyNonce() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "duty-nonce [consensus-address]",
+		Short: "Query the duty nonce the next metadata, rotation or binding message of a validator must carry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DutyNonce(cmd.Context(), &types.QueryDutyNonceRequest{
+				ConsAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdArchivedDutyMetadata returns the command to query the archived metadata of a removed validator
+func GetCmdArchivedDutyMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archived-duty-metadata [consensus-address]",
+		Short: "Query the duty metadata archived when a validator was removed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ArchivedDutyMetadata(cmd.Context(), &types.QueryArchivedDutyMetadataRequest{
+				ConsAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdArchivedDutyMetadataByCheckpointKey returns the command to look up removed validators by checkpoint key
+func GetCmdArchivedDutyMetadataByCheckpointKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archived-by-checkpoint-key [checkpoint-key-or-address]",
+		Short: "Query the archived metadata of removed validators that held a checkpoint key or signer address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ArchivedDutyMetadataByCheckpointKey(cmd.Context(), &types.QueryArchivedDutyMetadataByCheckpointKeyRequest{
+				CheckpointKey: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdConsensusKeyMigration returns the command to query consensus key migrations of a validator
+func GetCmdConsensusKeyMigration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-key-migration [consensus-address]",
+		Short: "Query the consensus key migrations to and from an address and the validator's current consensus address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ConsensusKeyMigration(cmd.Context(), &types.QueryConsensusKeyMigrationRequest{
+				ConsAddr: args[0],
 			})
 			if err != nil {
 				return err
@@ -0,0 +1,12 @@
+package exported
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Subspace is the part of the legacy x/params subspace the duty module still
+// reads, only to migrate its values into the params item.
+type Subspace interface {
+	GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet)
+}
@@ -1,24 +1,437 @@
 package genesis
 
 import (
-	"yourapp/x/duty/keeper"
-	"yourapp/x/duty/types"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/TheArticulation/Duty/x/duty/keeper"
+	"github.com/TheArticulation/Duty/x/duty/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type GenesisState struct {
-	Params types.Params `json:"params"`
+	Params        types.Params          `json:"params"`
+	DutyMetadata  []GenesisDutyMetadata `json:"duty_metadata"`
+	OriginDomains []types.OriginDomain  `json:"origin_domains,omitempty"`
+	Tombstones    []GenesisTombstone    `json:"tombstones,omitempty"`
+
+	ConsensusKeyMigrations []types.ConsensusKeyMigration `json:"consensus_key_migrations,omitempty"`
+
+	DutySetSnapshots        []types.DutySetSnapshot        `json:"duty_set_snapshots,omitempty"`
+	Checkpoints             []types.Checkpoint             `json:"checkpoints,omitempty"`
+	CheckpointLivenessQueue []GenesisCheckpointLiveness    `json:"checkpoint_liveness_queue,omitempty"`
+	CheckpointSigningInfos  []GenesisCheckpointSigningInfo `json:"checkpoint_signing_infos,omitempty"`
+	QuorumCoverageLow       bool                           `json:"quorum_coverage_low,omitempty"`
+	ValidatorConsAddrs      []GenesisValidatorConsAddr     `json:"validator_cons_addrs,omitempty"`
+	StorageAnnouncements    []types.StorageAnnouncement    `json:"storage_announcements,omitempty"`
+}
+
+// GenesisDutyMetadata is a validator's duty metadata keyed by consensus
+// address. The checkpoint address index is rebuilt from it on import.
+type GenesisDutyMetadata struct {
+	ValConsAddr string             `json:"val_cons_addr"`
+	Metadata    types.DutyMetadata `json:"metadata"`
+	DutyNonce   uint64             `json:"duty_nonce,omitempty"`
+}
+
+// GenesisTombstone is the archived metadata of a removed validator with the
+// duty nonce it had, which is kept so old signatures stay unusable.
+type GenesisTombstone struct {
+	Tombstone types.DutyMetadataTombstone `json:"tombstone"`
+	DutyNonce uint64                      `json:"duty_nonce,omitempty"`
+}
+
+// GenesisCheckpointLiveness is a quorum checkpoint whose signers are still to
+// be recorded for liveness at the deadline height.
+type GenesisCheckpointLiveness struct {
+	Deadline     int64  `json:"deadline"`
+	OriginDomain uint32 `json:"origin_domain"`
+	Index        uint32 `json:"index"`
+	Digest       string `json:"digest"`
+}
+
+// GenesisCheckpointSigningInfo is a validator's checkpoint liveness record
+// with the offsets of the checkpoints it missed in the current window.
+type GenesisCheckpointSigningInfo struct {
+	Info          types.CheckpointSigningInfo `json:"info"`
+	MissedIndexes []uint64                    `json:"missed_indexes,omitempty"`
+}
+
+// GenesisValidatorConsAddr is the consensus address a validator's duty state
+// is stored under, used to detect consensus key rotations.
+type GenesisValidatorConsAddr struct {
+	ValAddr     string `json:"val_addr"`
+	ValConsAddr string `json:"val_cons_addr"`
 }
 
 func DefaultGenesis() *GenesisState { return &GenesisState{Params: types.DefaultParams()} }
 
-func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *GenesisState) {
-	if err := data.Params.Validate(); err == nil {
-		k.SetParams(ctx, data.Params)
+// Validate checks params, address, key and storage URI formats, and that no
+// consensus address, checkpoint key (default or per-domain) or origin domain
+// appears twice. A validator may have live metadata and a tombstone.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenDomains := make(map[uint32]bool, len(gs.OriginDomains))
+	for i, domain := range gs.OriginDomains {
+		if err := domain.Validate(); err != nil {
+			return fmt.Errorf("origin_domains[%d]: %w", i, err)
+		}
+		if seenDomains[domain.DomainId] {
+			return fmt.Errorf("origin_domains[%d]: duplicate domain %d", i, domain.DomainId)
+		}
+		seenDomains[domain.DomainId] = true
+	}
+
+	seenCons := make(map[string]bool, len(gs.DutyMetadata))
+	seenKeys := make(map[string]string, len(gs.DutyMetadata))
+	for i, entry := range gs.DutyMetadata {
+		consAddr, err := sdk.ConsAddressFromBech32(entry.ValConsAddr)
+		if err != nil {
+			return fmt.Errorf("duty_metadata[%d]: invalid consensus address: %w", i, err)
+		}
+		if seenCons[consAddr.String()] {
+			return fmt.Errorf("duty_metadata[%d]: duplicate consensus address %s", i, consAddr)
+		}
+		seenCons[consAddr.String()] = true
+
+		if entry.Metadata.CheckpointPubKey != "" {
+			if _, err := types.NormalizeCheckpointKey(entry.Metadata.CheckpointPubKey); err != nil {
+				return fmt.Errorf("duty_metadata[%d]: %w", i, err)
+			}
+		}
+		if entry.Metadata.CheckpointStorageUri != "" {
+			if err := entry.Metadata.ValidateStorageURIs(gs.Params.MaxStorageUriLength); err != nil {
+				return fmt.Errorf("duty_metadata[%d]: %w", i, err)
+			}
+		}
+		if err := entry.Metadata.ValidateDomainConfigs(); err != nil {
+			return fmt.Errorf("duty_metadata[%d]: %w", i, err)
+		}
+		if pending := entry.Metadata.PendingKey; pending != nil {
+			if _, err := types.NormalizeCheckpointKey(pending.CheckpointPubKey); err != nil {
+				return fmt.Errorf("duty_metadata[%d]: pending key: %w", i, err)
+			}
+		}
+		for _, key := range entry.Metadata.CheckpointPubKeys() {
+			addr, _ := types.CheckpointAddress(key)
+			if owner, ok := seenKeys[string(addr)]; ok {
+				return fmt.Errorf("duty_metadata[%d]: checkpoint key 0x%x already used by %s", i, addr, owner)
+			}
+			seenKeys[string(addr)] = consAddr.String()
+		}
+	}
+
+	seenTombstones := make(map[string]bool, len(gs.Tombstones))
+	for i, entry := range gs.Tombstones {
+		consAddr, err := sdk.ConsAddressFromBech32(entry.Tombstone.ValConsAddr)
+		if err != nil {
+			return fmt.Errorf("tombstones[%d]: invalid consensus address: %w", i, err)
+		}
+		if seenTombstones[consAddr.String()] {
+			return fmt.Errorf("tombstones[%d]: duplicate consensus address %s", i, consAddr)
+		}
+		seenTombstones[consAddr.String()] = true
+	}
+
+	migrated := make(map[string]bool, len(gs.ConsensusKeyMigrations))
+	for i, m := range gs.ConsensusKeyMigrations {
+		oldAddr, err := sdk.ConsAddressFromBech32(m.OldConsAddr)
+		if err != nil {
+			return fmt.Errorf("consensus_key_migrations[%d]: invalid old consensus address: %w", i, err)
+		}
+		newAddr, err := sdk.ConsAddressFromBech32(m.NewConsAddr)
+		if err != nil {
+			return fmt.Errorf("consensus_key_migrations[%d]: invalid new consensus address: %w", i, err)
+		}
+		if oldAddr.Equals(newAddr) {
+			return fmt.Errorf("consensus_key_migrations[%d]: migration to the same address", i)
+		}
+		if migrated[oldAddr.String()] {
+			return fmt.Errorf("consensus_key_migrations[%d]: duplicate migration from %s", i, oldAddr)
+		}
+		migrated[oldAddr.String()] = true
+	}
+
+	seenEpochs := make(map[uint64]bool, len(gs.DutySetSnapshots))
+	seenHeights := make(map[int64]bool, len(gs.DutySetSnapshots))
+	for i, snapshot := range gs.DutySetSnapshots {
+		if seenEpochs[snapshot.Epoch] {
+			return fmt.Errorf("duty_set_snapshots[%d]: duplicate epoch %d", i, snapshot.Epoch)
+		}
+		if seenHeights[snapshot.Height] {
+			return fmt.Errorf("duty_set_snapshots[%d]: duplicate height %d", i, snapshot.Height)
+		}
+		seenEpochs[snapshot.Epoch] = true
+		seenHeights[snapshot.Height] = true
+	}
+
+	seenCheckpoints := make(map[string]bool, len(gs.Checkpoints))
+	for i, cp := range gs.Checkpoints {
+		if _, err := types.DecodeHex(cp.Digest); err != nil {
+			return fmt.Errorf("checkpoints[%d]: invalid digest: %w", i, err)
+		}
+		key := checkpointKey(cp.OriginDomain, cp.Index, cp.Digest)
+		if seenCheckpoints[key] {
+			return fmt.Errorf("checkpoints[%d]: duplicate checkpoint %s", i, key)
+		}
+		seenCheckpoints[key] = true
+	}
+	for i, entry := range gs.CheckpointLivenessQueue {
+		if key := checkpointKey(entry.OriginDomain, entry.Index, entry.Digest); !seenCheckpoints[key] {
+			return fmt.Errorf("checkpoint_liveness_queue[%d]: unknown checkpoint %s", i, key)
+		}
+	}
+
+	seenInfos := make(map[string]bool, len(gs.CheckpointSigningInfos))
+	for i, entry := range gs.CheckpointSigningInfos {
+		consAddr, err := sdk.ConsAddressFromBech32(entry.Info.ValConsAddr)
+		if err != nil {
+			return fmt.Errorf("checkpoint_signing_infos[%d]: invalid consensus address: %w", i, err)
+		}
+		if seenInfos[consAddr.String()] {
+			return fmt.Errorf("checkpoint_signing_infos[%d]: duplicate consensus address %s", i, consAddr)
+		}
+		seenInfos[consAddr.String()] = true
+	}
+
+	seenOperators := make(map[string]bool, len(gs.ValidatorConsAddrs))
+	for i, entry := range gs.ValidatorConsAddrs {
+		valAddr, err := sdk.ValAddressFromBech32(entry.ValAddr)
+		if err != nil {
+			return fmt.Errorf("validator_cons_addrs[%d]: invalid operator address: %w", i, err)
+		}
+		if _, err := sdk.ConsAddressFromBech32(entry.ValConsAddr); err != nil {
+			return fmt.Errorf("validator_cons_addrs[%d]: invalid consensus address: %w", i, err)
+		}
+		if seenOperators[valAddr.String()] {
+			return fmt.Errorf("validator_cons_addrs[%d]: duplicate operator address %s", i, valAddr)
+		}
+		seenOperators[valAddr.String()] = true
 	}
+
+	for i, a := range gs.StorageAnnouncements {
+		if a.OriginDomain == 0 {
+			return fmt.Errorf("storage_announcements[%d]: no origin domain", i)
+		}
+		if addr, err := types.DecodeHex(a.Validator); err != nil || len(addr) != 20 {
+			return fmt.Errorf("storage_announcements[%d]: validator %q is not a 20-byte hex address", i, a.Validator)
+		}
+	}
+	return nil
+}
+
+// checkpointKey identifies a checkpoint by origin domain, index and digest.
+func checkpointKey(originDomain, index uint32, digest string) string {
+	return fmt.Sprintf("%d/%d/%s", originDomain, index, types.NormalizeHex(digest))
+}
+
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("invalid duty genesis: %w", err)
+	}
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, entry := range data.DutyMetadata {
+		consAddr, _ := sdk.ConsAddressFromBech32(entry.ValConsAddr)
+		if err := entry.Metadata.Normalize(); err != nil {
+			return fmt.Errorf("duty metadata for %s: %w", entry.ValConsAddr, err)
+		}
+		if err := k.SetDutyMetadata(ctx, consAddr, entry.Metadata); err != nil {
+			return fmt.Errorf("duty metadata for %s: %w", entry.ValConsAddr, err)
+		}
+		if err := k.SetDutyNonce(ctx, consAddr, entry.DutyNonce); err != nil {
+			return err
+		}
+	}
+	for _, domain := range data.OriginDomains {
+		if err := k.SetOriginDomain(ctx, domain); err != nil {
+			return fmt.Errorf("origin domain %d: %w", domain.DomainId, err)
+		}
+	}
+	for _, entry := range data.Tombstones {
+		consAddr, _ := sdk.ConsAddressFromBech32(entry.Tombstone.ValConsAddr)
+		if err := k.SetTombstone(ctx, consAddr, entry.Tombstone); err != nil {
+			return fmt.Errorf("tombstone for %s: %w", entry.Tombstone.ValConsAddr, err)
+		}
+		// A validator with live metadata carries its nonce there
+		if _, live, err := k.GetDutyMetadata(ctx, consAddr); err != nil {
+			return err
+		} else if !live {
+			if err := k.SetDutyNonce(ctx, consAddr, entry.DutyNonce); err != nil {
+				return err
+			}
+		}
+	}
+	for _, m := range data.ConsensusKeyMigrations {
+		if err := k.SetConsensusKeyMigration(ctx, m); err != nil {
+			return fmt.Errorf("consensus key migration from %s: %w", m.OldConsAddr, err)
+		}
+	}
+	for _, snapshot := range data.DutySetSnapshots {
+		if err := k.SetDutySetSnapshot(ctx, snapshot); err != nil {
+			return fmt.Errorf("duty set snapshot for epoch %d: %w", snapshot.Epoch, err)
+		}
+	}
+	for _, cp := range data.Checkpoints {
+		digest, _ := types.DecodeHex(cp.Digest)
+		if err := k.SetCheckpoint(ctx, digest, cp); err != nil {
+			return fmt.Errorf("checkpoint %d/%d: %w", cp.OriginDomain, cp.Index, err)
+		}
+	}
+	for _, entry := range data.CheckpointLivenessQueue {
+		digest, _ := types.DecodeHex(entry.Digest)
+		if err := k.CheckpointLivenessQueue.Set(ctx, collections.Join(entry.Deadline, digest), collections.Join(entry.OriginDomain, entry.Index)); err != nil {
+			return err
+		}
+	}
+	for _, entry := range data.CheckpointSigningInfos {
+		consAddr, _ := sdk.ConsAddressFromBech32(entry.Info.ValConsAddr)
+		if err := k.SetCheckpointSigningInfo(ctx, consAddr, entry.Info); err != nil {
+			return fmt.Errorf("checkpoint signing info for %s: %w", entry.Info.ValConsAddr, err)
+		}
+		for _, index := range entry.MissedIndexes {
+			if err := k.CheckpointMissed.Set(ctx, collections.Join(consAddr, index)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, entry := range data.ValidatorConsAddrs {
+		valAddr, _ := sdk.ValAddressFromBech32(entry.ValAddr)
+		consAddr, _ := sdk.ConsAddressFromBech32(entry.ValConsAddr)
+		if err := k.SetValidatorConsAddr(ctx, valAddr, consAddr); err != nil {
+			return err
+		}
+	}
+	for _, a := range data.StorageAnnouncements {
+		if _, err := k.AddStorageAnnouncement(ctx, a); err != nil {
+			return fmt.Errorf("storage announcement of %s: %w", a.Validator, err)
+		}
+	}
+	if data.QuorumCoverageLow {
+		if err := k.QuorumCoverageLow.Set(ctx, true); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *GenesisState {
-	return &GenesisState{Params: k.GetParams(ctx)}
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) (*GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gs := &GenesisState{Params: params}
+	err = k.DutyMetadata.Walk(ctx, nil, func(valConsAddr sdk.ConsAddress, meta types.DutyMetadata) (bool, error) {
+		nonce, err := k.GetDutyNonce(ctx, valConsAddr)
+		if err != nil {
+			return true, err
+		}
+		gs.DutyMetadata = append(gs.DutyMetadata, GenesisDutyMetadata{
+			ValConsAddr: valConsAddr.String(),
+			Metadata:    meta,
+			DutyNonce:   nonce,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.OriginDomains.Walk(ctx, nil, func(_ uint32, domain types.OriginDomain) (bool, error) {
+		gs.OriginDomains = append(gs.OriginDomains, domain)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Tombstones.Walk(ctx, nil, func(valConsAddr sdk.ConsAddress, tombstone types.DutyMetadataTombstone) (bool, error) {
+		nonce, err := k.GetDutyNonce(ctx, valConsAddr)
+		if err != nil {
+			return true, err
+		}
+		gs.Tombstones = append(gs.Tombstones, GenesisTombstone{Tombstone: tombstone, DutyNonce: nonce})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.ConsensusKeyMigrations.Walk(ctx, nil, func(_ sdk.ConsAddress, m types.ConsensusKeyMigration) (bool, error) {
+		gs.ConsensusKeyMigrations = append(gs.ConsensusKeyMigrations, m)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.DutySetSnapshots.Walk(ctx, nil, func(_ uint64, snapshot types.DutySetSnapshot) (bool, error) {
+		gs.DutySetSnapshots = append(gs.DutySetSnapshots, snapshot)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Checkpoints.Walk(ctx, nil, func(_ collections.Triple[uint32, uint32, []byte], cp types.Checkpoint) (bool, error) {
+		gs.Checkpoints = append(gs.Checkpoints, cp)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.CheckpointLivenessQueue.Walk(ctx, nil, func(key collections.Pair[int64, []byte], ref collections.Pair[uint32, uint32]) (bool, error) {
+		gs.CheckpointLivenessQueue = append(gs.CheckpointLivenessQueue, GenesisCheckpointLiveness{
+			Deadline:     key.K1(),
+			OriginDomain: ref.K1(),
+			Index:        ref.K2(),
+			Digest:       "0x" + hex.EncodeToString(key.K2()),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.CheckpointSigningInfos.Walk(ctx, nil, func(valConsAddr sdk.ConsAddress, info types.CheckpointSigningInfo) (bool, error) {
+		entry := GenesisCheckpointSigningInfo{Info: info}
+		rng := collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](valConsAddr)
+		err := k.CheckpointMissed.Walk(ctx, rng, func(key collections.Pair[sdk.ConsAddress, uint64]) (bool, error) {
+			entry.MissedIndexes = append(entry.MissedIndexes, key.K2())
+			return false, nil
+		})
+		if err != nil {
+			return true, err
+		}
+		gs.CheckpointSigningInfos = append(gs.CheckpointSigningInfos, entry)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.ValidatorConsAddrs.Walk(ctx, nil, func(valAddr sdk.ValAddress, consAddr sdk.ConsAddress) (bool, error) {
+		gs.ValidatorConsAddrs = append(gs.ValidatorConsAddrs, GenesisValidatorConsAddr{
+			ValAddr:     valAddr.String(),
+			ValConsAddr: consAddr.String(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.StorageAnnouncements.Walk(ctx, nil, func(_ collections.Triple[uint32, []byte, uint64], a types.StorageAnnouncement) (bool, error) {
+		gs.StorageAnnouncements = append(gs.StorageAnnouncements, a)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	gs.QuorumCoverageLow, err = k.QuorumCoverageLow.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	return gs, nil
 }
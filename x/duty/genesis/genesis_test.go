@@ -0,0 +1,370 @@
+package genesis
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TheArticulation/Duty/x/duty/keeper"
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// setupGenesisKeeper returns a keeper on an empty in-memory store.
+func setupGenesisKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	db := dbm.NewMemDB()
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, cmtproto.Header{}, false, log.NewNopLogger())
+	k := keeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		runtime.NewKVStoreService(storeKey),
+		nil, nil,
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	return k, ctx
+}
+
+func TestGenesisState_Validate(t *testing.T) {
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	key := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	sameKeyUncompressed := "0x" + hex.EncodeToString(priv.PubKey().SerializeUncompressed())
+
+	valA := sdk.ConsAddress([]byte("validator-a")).String()
+	valB := sdk.ConsAddress([]byte("validator-b")).String()
+
+	// Default genesis is valid
+	assert.NoError(t, DefaultGenesis().Validate())
+
+	// Valid metadata
+	gs := DefaultGenesis()
+	gs.DutyMetadata = []GenesisDutyMetadata{
+		{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: key, CheckpointStorageUri: "s3://bucket/a/"}},
+	}
+	assert.NoError(t, gs.Validate())
+
+	// Invalid params
+	gs = DefaultGenesis()
+	gs.Params.QuorumDenominator = 0
+	assert.Error(t, gs.Validate())
+
+	// Invalid consensus address
+	gs = DefaultGenesis()
+	gs.DutyMetadata = []GenesisDutyMetadata{{ValConsAddr: "cosmosvalcons1invalid", Metadata: types.DutyMetadata{CheckpointPubKey: key}}}
+	assert.Error(t, gs.Validate())
+
+	// Invalid checkpoint key
+	gs = DefaultGenesis()
+	gs.DutyMetadata = []GenesisDutyMetadata{{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: "0x1234"}}}
+	assert.Error(t, gs.Validate())
+
+	// Duplicate consensus address
+	gs = DefaultGenesis()
+	gs.DutyMetadata = []GenesisDutyMetadata{
+		{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: key}},
+		{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: key}},
+	}
+	assert.Error(t, gs.Validate())
+
+	// Duplicate checkpoint key, even in another encoding
+	gs = DefaultGenesis()
+	gs.DutyMetadata = []GenesisDutyMetadata{
+		{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: key}},
+		{ValConsAddr: valB, Metadata: types.DutyMetadata{CheckpointPubKey: sameKeyUncompressed}},
+	}
+	assert.Error(t, gs.Validate())
+
+	// A per-domain key cannot reuse another validator's key either
+	other, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	gs = DefaultGenesis()
+	gs.DutyMetadata = []GenesisDutyMetadata{
+		{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: key}},
+		{ValConsAddr: valB, Metadata: types.DutyMetadata{
+			CheckpointPubKey: "0x" + hex.EncodeToString(other.PubKey().SerializeCompressed()),
+			DomainConfigs:    []*types.DomainCheckpointConfig{{OriginDomain: 1, CheckpointPubKey: key}},
+		}},
+	}
+	assert.Error(t, gs.Validate())
+
+	// Origin domains must be valid and unique
+	domain := types.OriginDomain{
+		DomainId:       1,
+		Name:           "ethereum",
+		Mailbox:        "0x" + strings.Repeat("ab", 32),
+		MerkleTreeHook: "0x" + strings.Repeat("cd", 32),
+	}
+	gs = DefaultGenesis()
+	gs.OriginDomains = []types.OriginDomain{domain}
+	assert.NoError(t, gs.Validate())
+	gs.OriginDomains = []types.OriginDomain{domain, domain}
+	assert.Error(t, gs.Validate())
+
+	// A released key may be both archived and live; tombstones are unique
+	gs = DefaultGenesis()
+	gs.DutyMetadata = []GenesisDutyMetadata{{ValConsAddr: valB, Metadata: types.DutyMetadata{CheckpointPubKey: key}}}
+	tombstone := GenesisTombstone{Tombstone: types.DutyMetadataTombstone{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: key}}}
+	gs.Tombstones = []GenesisTombstone{tombstone}
+	assert.NoError(t, gs.Validate())
+	gs.Tombstones = []GenesisTombstone{tombstone, tombstone}
+	assert.Error(t, gs.Validate())
+
+	// An address can only be migrated away from once
+	gs = DefaultGenesis()
+	migration := types.ConsensusKeyMigration{OldConsAddr: valA, NewConsAddr: valB, Height: 10}
+	gs.ConsensusKeyMigrations = []types.ConsensusKeyMigration{migration}
+	assert.NoError(t, gs.Validate())
+	gs.ConsensusKeyMigrations = []types.ConsensusKeyMigration{migration, migration}
+	assert.Error(t, gs.Validate())
+	gs.ConsensusKeyMigrations = []types.ConsensusKeyMigration{{OldConsAddr: valA, NewConsAddr: valA}}
+	assert.Error(t, gs.Validate())
+}
+
+func TestGenesisState_ValidateKeysAndMigrations(t *testing.T) {
+	newKey := func() string {
+		priv, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		return "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	}
+	keyA, keyB, keyC := newKey(), newKey(), newKey()
+	valA := sdk.ConsAddress([]byte("validator-a")).String()
+	valB := sdk.ConsAddress([]byte("validator-b")).String()
+	domainKey := func(domain uint32, key string) []*types.DomainCheckpointConfig {
+		return []*types.DomainCheckpointConfig{{OriginDomain: domain, CheckpointPubKey: key}}
+	}
+
+	testCases := []struct {
+		name       string
+		metadata   []GenesisDutyMetadata
+		migrations []types.ConsensusKeyMigration
+		expErr     bool
+	}{
+		{
+			name: "distinct default and per-domain keys",
+			metadata: []GenesisDutyMetadata{
+				{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: keyA, DomainConfigs: domainKey(1, keyB)}},
+				{ValConsAddr: valB, Metadata: types.DutyMetadata{CheckpointPubKey: keyC}},
+			},
+		},
+		{
+			name: "validator reuses its default key for a domain",
+			metadata: []GenesisDutyMetadata{
+				{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: keyA, DomainConfigs: domainKey(1, keyA)}},
+			},
+		},
+		{
+			name: "default key reused as another validator's domain key",
+			metadata: []GenesisDutyMetadata{
+				{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: keyA}},
+				{ValConsAddr: valB, Metadata: types.DutyMetadata{CheckpointPubKey: keyB, DomainConfigs: domainKey(1, keyA)}},
+			},
+			expErr: true,
+		},
+		{
+			name: "domain key reused as another validator's default key",
+			metadata: []GenesisDutyMetadata{
+				{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: keyA, DomainConfigs: domainKey(1, keyB)}},
+				{ValConsAddr: valB, Metadata: types.DutyMetadata{CheckpointPubKey: keyB}},
+			},
+			expErr: true,
+		},
+		{
+			name: "domain key reused for another validator's other domain",
+			metadata: []GenesisDutyMetadata{
+				{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: keyA, DomainConfigs: domainKey(1, keyC)}},
+				{ValConsAddr: valB, Metadata: types.DutyMetadata{CheckpointPubKey: keyB, DomainConfigs: domainKey(2, keyC)}},
+			},
+			expErr: true,
+		},
+		{
+			name: "valid pending key",
+			metadata: []GenesisDutyMetadata{
+				{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: keyA, PendingKey: &types.CheckpointKeyRecord{CheckpointPubKey: keyB, ActivationHeight: 10}}},
+			},
+		},
+		{
+			name: "malformed pending key",
+			metadata: []GenesisDutyMetadata{
+				{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: keyA, PendingKey: &types.CheckpointKeyRecord{CheckpointPubKey: "0x1234", ActivationHeight: 10}}},
+			},
+			expErr: true,
+		},
+		{
+			name: "pending key held by another validator",
+			metadata: []GenesisDutyMetadata{
+				{ValConsAddr: valA, Metadata: types.DutyMetadata{CheckpointPubKey: keyA, PendingKey: &types.CheckpointKeyRecord{CheckpointPubKey: keyB, ActivationHeight: 10}}},
+				{ValConsAddr: valB, Metadata: types.DutyMetadata{CheckpointPubKey: keyB}},
+			},
+			expErr: true,
+		},
+		{
+			name:       "migration to another address",
+			migrations: []types.ConsensusKeyMigration{{OldConsAddr: valA, NewConsAddr: valB, Height: 10}},
+		},
+		{
+			name:       "migration to the same address",
+			migrations: []types.ConsensusKeyMigration{{OldConsAddr: valA, NewConsAddr: valA, Height: 10}},
+			expErr:     true,
+		},
+		{
+			name:       "migration to an invalid address",
+			migrations: []types.ConsensusKeyMigration{{OldConsAddr: valA, NewConsAddr: "cosmosvalcons1invalid", Height: 10}},
+			expErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := DefaultGenesis()
+			gs.DutyMetadata = tc.metadata
+			gs.ConsensusKeyMigrations = tc.migrations
+			if tc.expErr {
+				assert.Error(t, gs.Validate())
+			} else {
+				assert.NoError(t, gs.Validate())
+			}
+		})
+	}
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	key := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	valA := sdk.ConsAddress([]byte("validator-a"))
+	valB := sdk.ConsAddress([]byte("validator-b"))
+	valC := sdk.ConsAddress([]byte("validator-c"))
+	valD := sdk.ConsAddress([]byte("validator-d"))
+	domainPriv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	domainKey := "0x" + hex.EncodeToString(domainPriv.PubKey().SerializeCompressed())
+
+	k, ctx := setupGenesisKeeper(t)
+	require.NoError(t, InitGenesis(ctx, k, &GenesisState{
+		Params: types.DefaultParams(),
+		DutyMetadata: []GenesisDutyMetadata{
+			{ValConsAddr: valA.String(), Metadata: types.DutyMetadata{CheckpointPubKey: key, CheckpointStorageUri: "s3://bucket/a/"}, DutyNonce: 3},
+		},
+	}))
+
+	// Populate the state that is only written while the chain runs
+	// Metadata set before a default key is bound has no default key
+	require.NoError(t, k.SetDutyMetadata(ctx, valC, types.DutyMetadata{CheckpointStorageUri: "s3://bucket/c/"}))
+	require.NoError(t, k.SetDutyMetadata(ctx, valD, types.DutyMetadata{
+		DomainConfigs: []*types.DomainCheckpointConfig{{OriginDomain: 1, CheckpointPubKey: domainKey}},
+	}))
+	for epoch := uint64(1); epoch <= 2; epoch++ {
+		require.NoError(t, k.SetDutySetSnapshot(ctx, types.DutySetSnapshot{
+			Epoch:  epoch,
+			Height: int64(epoch * 100),
+			Validators: []*types.DutyValidator{
+				{ValConsAddr: valA.String(), VotingPower: "10", CheckpointPubKey: key},
+			},
+			QuorumNum: types.DefaultQuorumNum,
+			QuorumDen: types.DefaultQuorumDen,
+			SetHash:   []byte{byte(epoch)},
+		}))
+	}
+	digest := []byte(strings.Repeat("d", 32))
+	cp := types.Checkpoint{
+		OriginDomain:     1,
+		MerkleTreeHook:   "0x" + strings.Repeat("cd", 32),
+		Root:             "0x" + strings.Repeat("aa", 32),
+		Index:            4,
+		MessageId:        "0x" + strings.Repeat("ef", 32),
+		Digest:           "0x" + hex.EncodeToString(digest),
+		Epoch:            2,
+		Signatures:       []types.CheckpointSignature{{ValConsAddr: valA.String(), Signature: "0x" + strings.Repeat("11", 65)}},
+		SignedPower:      "10",
+		TotalPower:       "10",
+		QuorumReached:    true,
+		QuorumHeight:     210,
+		LivenessDeadline: 230,
+	}
+	require.NoError(t, k.SetCheckpoint(ctx, digest, cp))
+	require.NoError(t, k.CheckpointLivenessQueue.Set(ctx, collections.Join(cp.LivenessDeadline, digest), collections.Join(cp.OriginDomain, cp.Index)))
+	require.NoError(t, k.SetCheckpointSigningInfo(ctx, valB, types.CheckpointSigningInfo{
+		ValConsAddr:              valB.String(),
+		StartHeight:              100,
+		IndexOffset:              5,
+		MissedCheckpointsCounter: 2,
+	}))
+	require.NoError(t, k.CheckpointMissed.Set(ctx, collections.Join(valB, uint64(1))))
+	require.NoError(t, k.CheckpointMissed.Set(ctx, collections.Join(valB, uint64(3))))
+	require.NoError(t, k.QuorumCoverageLow.Set(ctx, true))
+	require.NoError(t, k.SetValidatorConsAddr(ctx, sdk.ValAddress([]byte("operator-a")), valA))
+	for _, location := range []string{"s3://bucket/a/", "s3://bucket/b/"} {
+		_, err := k.AddStorageAnnouncement(ctx, types.StorageAnnouncement{
+			OriginDomain:    1,
+			Validator:       "0x" + strings.Repeat("22", 20),
+			StorageLocation: location,
+			Signature:       "0x" + strings.Repeat("33", 65),
+		})
+		require.NoError(t, err)
+	}
+
+	exported, err := ExportGenesis(ctx, k)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	assert.Len(t, exported.DutySetSnapshots, 2)
+	assert.Len(t, exported.Checkpoints, 1)
+	assert.Len(t, exported.CheckpointLivenessQueue, 1)
+	require.Len(t, exported.CheckpointSigningInfos, 1)
+	assert.Equal(t, []uint64{1, 3}, exported.CheckpointSigningInfos[0].MissedIndexes)
+	assert.True(t, exported.QuorumCoverageLow)
+	assert.Len(t, exported.ValidatorConsAddrs, 1)
+	assert.Len(t, exported.StorageAnnouncements, 2)
+	assert.Len(t, exported.DutyMetadata, 3)
+
+	// Import through JSON like the module does, then export again
+	bz, err := json.Marshal(exported)
+	require.NoError(t, err)
+	var imported GenesisState
+	require.NoError(t, json.Unmarshal(bz, &imported))
+	k2, ctx2 := setupGenesisKeeper(t)
+	require.NoError(t, InitGenesis(ctx2, k2, &imported))
+	reexported, err := ExportGenesis(ctx2, k2)
+	require.NoError(t, err)
+	assert.Equal(t, exported, reexported)
+
+	// The imported state is reachable through the keeper
+	snapshot, found, err := k2.GetDutySetByEpoch(ctx2, 2)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, int64(200), snapshot.Height)
+	gotCp, found, err := k2.GetCheckpoint(ctx2, 1, 4, digest)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, cp.LivenessDeadline, gotCp.LivenessDeadline)
+	addr, err := types.CheckpointAddress(key)
+	require.NoError(t, err)
+	owner, found, err := k2.GetConsAddrByCheckpointAddress(ctx2, addr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, valA, owner)
+
+	// A queued checkpoint must be exported too
+	imported.Checkpoints = nil
+	assert.Error(t, imported.Validate())
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker commits the pending duty set at epoch boundaries. Staking changes
+// in between only affect the pending set, so the active set stays stable for
+// the whole epoch. The very first set is committed immediately. Checkpoint
+// key rotations that are due are activated first, so a committed set carries
+// the new keys, and quorum coverage of the live set is checked every block.
+// Liveness of quorum checkpoints whose grace period ends is recorded before
+// snapshots are pruned. Duty state of validators that rotated their consensus
+// key is moved to the new address before anything else.
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	if err := k.MigrateRotatedConsensusKeys(ctx); err != nil {
+		return err
+	}
+	if err := k.ActivatePendingCheckpointKeys(ctx); err != nil {
+		return err
+	}
+	if err := k.checkQuorumCoverage(ctx); err != nil {
+		return err
+	}
+	if err := k.RecordDueCheckpointLiveness(ctx); err != nil {
+		return err
+	}
+
+	_, hasActive, err := k.GetActiveDutySet(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if hasActive && ctx.BlockHeight()%int64(params.EpochLength) != 0 {
+		return nil
+	}
+	return k.SnapshotDutySet(ctx)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// SetCheckpoint stores the aggregated signatures for a checkpoint digest.
+func (k Keeper) SetCheckpoint(ctx sdk.Context, digest []byte, cp types.Checkpoint) error {
+	return k.Checkpoints.Set(ctx, collections.Join3(cp.OriginDomain, cp.Index, digest), cp)
+}
+
+// GetCheckpoint returns the aggregated checkpoint for a digest.
+func (k Keeper) GetCheckpoint(ctx sdk.Context, originDomain, index uint32, digest []byte) (types.Checkpoint, bool, error) {
+	return lookup(k.Checkpoints.Get(ctx, collections.Join3(originDomain, index, digest)))
+}
+
+// GetCheckpointsAtIndex returns every checkpoint signed for an origin domain
+// and index. Honest validators only ever produce one.
+func (k Keeper) GetCheckpointsAtIndex(ctx sdk.Context, originDomain, index uint32) ([]types.Checkpoint, error) {
+	rng := collections.NewSuperPrefixedTripleRange[uint32, uint32, []byte](originDomain, index)
+	iter, err := k.Checkpoints.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// AddCheckpointSignature verifies a validator's signature over a checkpoint
+// against the duty set of the epoch the checkpoint was first signed in,
+// accumulates its voting power and marks the checkpoint once quorum is reached.
+// Keys are resolved for the origin domain, and a registered domain's hook and
+// quorum override apply. A checkpoint reaching quorum is queued for liveness,
+// recorded once its signing grace period has passed.
+func (k Keeper) AddCheckpointSignature(ctx sdk.Context, consAddr sdk.ConsAddress, msg *types.MsgSubmitCheckpointSignature) (types.Checkpoint, error) {
+	digest, err := msg.Digest()
+	if err != nil {
+		return types.Checkpoint{}, err
+	}
+
+	domain, registered, err := k.GetOriginDomain(ctx, msg.OriginDomain)
+	if err != nil {
+		return types.Checkpoint{}, err
+	}
+	if registered && types.NormalizeHex(msg.MerkleTreeHook) != domain.MerkleTreeHook {
+		return types.Checkpoint{}, types.ErrInvalidCheckpoint.Wrapf("merkle tree hook %s is not the registered hook of domain %d", msg.MerkleTreeHook, msg.OriginDomain)
+	}
+
+	cp, found, err := k.GetCheckpoint(ctx, msg.OriginDomain, msg.Index, digest)
+	if err != nil {
+		return types.Checkpoint{}, err
+	}
+	var dutySet types.DutySetSnapshot
+	if found {
+		if dutySet, found, err = k.GetDutySetByEpoch(ctx, cp.Epoch); err != nil {
+			return types.Checkpoint{}, err
+		} else if !found {
+			return types.Checkpoint{}, types.ErrSnapshotNotFound.Wrapf("epoch %d", cp.Epoch)
+		}
+	} else {
+		if dutySet, found, err = k.GetActiveDutySet(ctx); err != nil {
+			return types.Checkpoint{}, err
+		} else if !found {
+			return types.Checkpoint{}, types.ErrNotInDutySet.Wrap("no active duty set")
+		}
+		cp = types.Checkpoint{
+			OriginDomain:   msg.OriginDomain,
+			MerkleTreeHook: types.NormalizeHex(msg.MerkleTreeHook),
+			Root:           types.NormalizeHex(msg.Root),
+			Index:          msg.Index,
+			MessageId:      types.NormalizeHex(msg.MessageId),
+			Digest:         "0x" + hex.EncodeToString(digest),
+			Epoch:          dutySet.Epoch,
+			SignedPower:    math.ZeroInt().String(),
+			TotalPower:     types.TotalVotingPower(dutySet.Validators).String(),
+		}
+	}
+	if registered {
+		dutySet = dutySet.ForDomain(msg.OriginDomain, &domain)
+	} else {
+		dutySet = dutySet.ForDomain(msg.OriginDomain, nil)
+	}
+
+	member, ok, err := k.findDutySetMember(ctx, dutySet, consAddr)
+	if err != nil {
+		return types.Checkpoint{}, err
+	}
+	if !ok {
+		return types.Checkpoint{}, types.ErrNotInDutySet.Wrapf("%s in epoch %d", consAddr, dutySet.Epoch)
+	}
+	if member.CheckpointPubKey == "" {
+		return types.Checkpoint{}, types.ErrInvalidCheckpointKey.Wrapf("no checkpoint key for %s in epoch %d", consAddr, dutySet.Epoch)
+	}
+	for _, sig := range cp.Signatures {
+		if sig.ValConsAddr == member.ValConsAddr {
+			return types.Checkpoint{}, types.ErrDuplicateSignature.Wrap(member.ValConsAddr)
+		}
+	}
+	if err := k.verifyCheckpointSigner(ctx, consAddr, msg.OriginDomain, member.CheckpointPubKey, digest, msg.Signature); err != nil {
+		return types.Checkpoint{}, err
+	}
+
+	power, ok := math.NewIntFromString(member.VotingPower)
+	if !ok {
+		power = math.ZeroInt()
+	}
+	signed, _ := math.NewIntFromString(cp.SignedPower)
+	signed = signed.Add(power)
+
+	cp.Signatures = append(cp.Signatures, types.CheckpointSignature{
+		ValConsAddr: member.ValConsAddr,
+		Signature:   msg.Signature,
+		VotingPower: power.String(),
+	})
+	cp.SignedPower = signed.String()
+
+	if !cp.QuorumReached && dutySet.QuorumReachedBy(signed, len(cp.Signatures)) {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return types.Checkpoint{}, err
+		}
+		cp.QuorumReached = true
+		cp.QuorumHeight = ctx.BlockHeight()
+		cp.LivenessDeadline = cp.QuorumHeight + int64(params.CheckpointSigningGracePeriod)
+		if err := k.CheckpointLivenessQueue.Set(ctx, collections.Join(cp.LivenessDeadline, digest), collections.Join(cp.OriginDomain, cp.Index)); err != nil {
+			return types.Checkpoint{}, err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent("duty_checkpoint_quorum_reached",
+				sdk.NewAttribute("origin_domain", fmt.Sprintf("%d", cp.OriginDomain)),
+				sdk.NewAttribute("index", fmt.Sprintf("%d", cp.Index)),
+				sdk.NewAttribute("root", cp.Root),
+				sdk.NewAttribute("message_id", cp.MessageId),
+				sdk.NewAttribute("signed_power", cp.SignedPower),
+				sdk.NewAttribute("total_power", cp.TotalPower),
+				sdk.NewAttribute("liveness_deadline", fmt.Sprintf("%d", cp.LivenessDeadline)),
+				sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+			),
+		)
+	}
+
+	if err := k.SetCheckpoint(ctx, digest, cp); err != nil {
+		return types.Checkpoint{}, err
+	}
+	return cp, nil
+}
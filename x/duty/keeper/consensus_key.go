@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// MigrateConsensusAddress moves a validator's duty state from oldAddr to
+// newAddr after its consensus key was rotated: metadata, a pending key
+// rotation, the duty nonce and checkpoint liveness. The old address is
+// recorded so duty sets committed before the rotation still resolve to the
+// validator.
+func (k Keeper) MigrateConsensusAddress(ctx sdk.Context, oldAddr, newAddr sdk.ConsAddress) error {
+	if oldAddr.Equals(newAddr) {
+		return nil
+	}
+	if _, found, err := k.GetDutyMetadata(ctx, newAddr); err != nil {
+		return err
+	} else if found {
+		return fmt.Errorf("duty metadata already exists for %s", newAddr)
+	}
+
+	meta, found, err := k.GetDutyMetadata(ctx, oldAddr)
+	if err != nil {
+		return err
+	}
+	if found {
+		// Remove first so the checkpoint keys are free for the new address
+		if meta.PendingKey != nil {
+			if err := k.PendingKeyRotations.Remove(ctx, collections.Join(meta.PendingKey.ActivationHeight, oldAddr)); err != nil {
+				return err
+			}
+		}
+		if err := k.DutyMetadata.Remove(ctx, oldAddr); err != nil {
+			return err
+		}
+		if err := k.SetDutyMetadata(ctx, newAddr, meta); err != nil {
+			return err
+		}
+	}
+
+	nonce, err := k.GetDutyNonce(ctx, oldAddr)
+	if err != nil {
+		return err
+	}
+	if err := k.SetDutyNonce(ctx, newAddr, nonce); err != nil {
+		return err
+	}
+	if err := k.SetDutyNonce(ctx, oldAddr, 0); err != nil {
+		return err
+	}
+
+	if err := k.migrateCheckpointLiveness(ctx, oldAddr, newAddr); err != nil {
+		return err
+	}
+
+	migration := types.ConsensusKeyMigration{
+		OldConsAddr: oldAddr.String(),
+		NewConsAddr: newAddr.String(),
+		Height:      ctx.BlockHeight(),
+	}
+	if err := k.SetConsensusKeyMigration(ctx, migration); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("duty_consensus_key_migrated",
+			sdk.NewAttribute("old_cons_addr", migration.OldConsAddr),
+			sdk.NewAttribute("new_cons_addr", migration.NewConsAddr),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+	return nil
+}
+
+// SetValidatorConsAddr records the consensus address a validator's duty state
+// is stored under, so MigrateRotatedConsensusKeys can detect a rotation.
+func (k Keeper) SetValidatorConsAddr(ctx sdk.Context, valAddr sdk.ValAddress, consAddr sdk.ConsAddress) error {
+	return k.ValidatorConsAddrs.Set(ctx, valAddr, consAddr)
+}
+
+// MigrateRotatedConsensusKeys compares the consensus address every tracked
+// validator's duty state is stored under with its current consensus address
+// and, after a consensus key rotation, moves the state with
+// MigrateConsensusAddress. The v0.50 staking hooks have no rotation hook, so
+// EndBlock polls instead. A migration that fails is logged and not retried;
+// validators that no longer exist are dropped.
+func (k Keeper) MigrateRotatedConsensusKeys(ctx sdk.Context) error {
+	type trackedValidator struct {
+		valAddr  sdk.ValAddress
+		consAddr sdk.ConsAddress
+	}
+	var tracked []trackedValidator
+	err := k.ValidatorConsAddrs.Walk(ctx, nil, func(valAddr sdk.ValAddress, consAddr sdk.ConsAddress) (bool, error) {
+		tracked = append(tracked, trackedValidator{valAddr: valAddr, consAddr: consAddr})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tracked {
+		validator, err := k.stakingKeeper.GetValidator(ctx, t.valAddr)
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			if err := k.ValidatorConsAddrs.Remove(ctx, t.valAddr); err != nil {
+				return err
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+		current, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		if t.consAddr.Equals(sdk.ConsAddress(current)) {
+			continue
+		}
+
+		// Migrate in a cache context so a failure leaves no partial state
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.MigrateConsensusAddress(cacheCtx, t.consAddr, current); err != nil {
+			k.logger.Error("failed to migrate duty state after consensus key rotation",
+				"val_addr", t.valAddr.String(), "old_cons_addr", t.consAddr.String(), "new_cons_addr", sdk.ConsAddress(current).String(), "err", err)
+		} else {
+			write()
+		}
+		if err := k.SetValidatorConsAddr(ctx, t.valAddr, current); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// trackValidatorConsAddrs records the operator of every stored metadata entry
+// whose validator is known to the staking keeper, for state written before
+// ValidatorConsAddrs existed.
+func (k Keeper) trackValidatorConsAddrs(ctx sdk.Context) error {
+	var consAddrs []sdk.ConsAddress
+	err := k.IterateDutyMetadata(ctx, func(valConsAddr sdk.ConsAddress, _ types.DutyMetadata) bool {
+		consAddrs = append(consAddrs, valConsAddr)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	for _, consAddr := range consAddrs {
+		validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		if err != nil {
+			return err
+		}
+		if err := k.SetValidatorConsAddr(ctx, valAddr, consAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) migrateCheckpointLiveness(ctx sdk.Context, oldAddr, newAddr sdk.ConsAddress) error {
+	info, found, err := k.GetCheckpointSigningInfo(ctx, oldAddr)
+	if err != nil || !found {
+		return err
+	}
+	info.ValConsAddr = newAddr.String()
+	if err := k.SetCheckpointSigningInfo(ctx, newAddr, info); err != nil {
+		return err
+	}
+	if err := k.CheckpointSigningInfos.Remove(ctx, oldAddr); err != nil {
+		return err
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](oldAddr)
+	iter, err := k.CheckpointMissed.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.CheckpointMissed.Set(ctx, collections.Join(newAddr, key.K2())); err != nil {
+			return err
+		}
+	}
+	return k.clearCheckpointMissed(ctx, oldAddr)
+}
+
+// SetConsensusKeyMigration records a migration and its reverse lookup.
+func (k Keeper) SetConsensusKeyMigration(ctx sdk.Context, migration types.ConsensusKeyMigration) error {
+	oldAddr, err := sdk.ConsAddressFromBech32(migration.OldConsAddr)
+	if err != nil {
+		return err
+	}
+	newAddr, err := sdk.ConsAddressFromBech32(migration.NewConsAddr)
+	if err != nil {
+		return err
+	}
+	if err := k.ConsensusKeyMigrationsByNew.Set(ctx, collections.Join(newAddr, oldAddr)); err != nil {
+		return err
+	}
+	return k.ConsensusKeyMigrations.Set(ctx, oldAddr, migration)
+}
+
+// GetConsensusKeyMigration returns the migration away from consAddr, if its
+// consensus key was rotated.
+func (k Keeper) GetConsensusKeyMigration(ctx sdk.Context, consAddr sdk.ConsAddress) (types.ConsensusKeyMigration, bool, error) {
+	return lookup(k.ConsensusKeyMigrations.Get(ctx, consAddr))
+}
+
+// GetConsensusKeyMigrationTo returns the migration that moved a validator to
+// consAddr, if consAddr is the result of a rotation.
+func (k Keeper) GetConsensusKeyMigrationTo(ctx sdk.Context, consAddr sdk.ConsAddress) (types.ConsensusKeyMigration, bool, error) {
+	rng := collections.NewPrefixedPairRange[sdk.ConsAddress, sdk.ConsAddress](consAddr)
+	iter, err := k.ConsensusKeyMigrationsByNew.Iterate(ctx, rng)
+	if err != nil {
+		return types.ConsensusKeyMigration{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.ConsensusKeyMigration{}, false, nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return types.ConsensusKeyMigration{}, false, err
+	}
+	return k.GetConsensusKeyMigration(ctx, key.K2())
+}
+
+// CurrentConsAddress follows recorded migrations from consAddr to the
+// validator's current consensus address.
+func (k Keeper) CurrentConsAddress(ctx sdk.Context, consAddr sdk.ConsAddress) (sdk.ConsAddress, error) {
+	// A rotation can never return to an address in use, but bound the walk
+	// in case state was imported with a cycle
+	for i := 0; i < maxConsensusKeyMigrations; i++ {
+		migration, found, err := k.GetConsensusKeyMigration(ctx, consAddr)
+		if err != nil || !found {
+			return consAddr, err
+		}
+		if consAddr, err = sdk.ConsAddressFromBech32(migration.NewConsAddr); err != nil {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("consensus key migrations from %s do not end", consAddr)
+}
+
+// maxConsensusKeyMigrations bounds the migration chains CurrentConsAddress
+// and the duty set lookups follow.
+const maxConsensusKeyMigrations = 100
+
+// findDutySetMember returns consAddr's entry in dutySet. A validator whose
+// consensus key was rotated after the set was committed is found under its
+// earlier address.
+func (k Keeper) findDutySetMember(ctx sdk.Context, dutySet types.DutySetSnapshot, consAddr sdk.ConsAddress) (*types.DutyValidator, bool, error) {
+	for i := 0; i < maxConsensusKeyMigrations; i++ {
+		if member, ok := dutySet.FindValidator(consAddr.String()); ok {
+			return member, true, nil
+		}
+		migration, found, err := k.GetConsensusKeyMigrationTo(ctx, consAddr)
+		if err != nil || !found {
+			return nil, false, err
+		}
+		if consAddr, err = sdk.ConsAddressFromBech32(migration.OldConsAddr); err != nil {
+			return nil, false, err
+		}
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// SetOriginDomain validates and stores a registry entry under its domain ID,
+// replacing any existing one.
+func (k Keeper) SetOriginDomain(ctx sdk.Context, domain types.OriginDomain) error {
+	if err := domain.Validate(); err != nil {
+		return err
+	}
+	domain.Normalize()
+	return k.OriginDomains.Set(ctx, domain.DomainId, domain)
+}
+
+// GetOriginDomain returns the registry entry for a domain ID.
+func (k Keeper) GetOriginDomain(ctx sdk.Context, domainID uint32) (types.OriginDomain, bool, error) {
+	return lookup(k.OriginDomains.Get(ctx, domainID))
+}
+
+// RemoveOriginDomain deletes a registry entry. Checkpoints already collected
+// for the domain are kept.
+func (k Keeper) RemoveOriginDomain(ctx sdk.Context, domainID uint32) error {
+	has, err := k.OriginDomains.Has(ctx, domainID)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrInvalidOriginDomain.Wrapf("domain %d not registered", domainID)
+	}
+	return k.OriginDomains.Remove(ctx, domainID)
+}
+
+// dutySetForDomain resolves a duty set for originDomain: per-domain keys and
+// storage URIs, and the domain's quorum override if it is registered with one.
+func (k Keeper) dutySetForDomain(ctx sdk.Context, dutySet types.DutySetSnapshot, originDomain uint32) (types.DutySetSnapshot, error) {
+	domain, found, err := k.GetOriginDomain(ctx, originDomain)
+	if err != nil {
+		return types.DutySetSnapshot{}, err
+	}
+	if !found {
+		return dutySet.ForDomain(originDomain, nil), nil
+	}
+	return dutySet.ForDomain(originDomain, &domain), nil
+}
+
+// verifyAnnouncement checks a storage announcement against the key and
+// storage URI meta uses for the announced domain, which must be registered.
+func (k Keeper) verifyAnnouncement(ctx sdk.Context, meta types.DutyMetadata, a types.ValidatorAnnouncement) (types.StorageAnnouncement, error) {
+	domain, found, err := k.GetOriginDomain(ctx, a.OriginDomain)
+	if err != nil {
+		return types.StorageAnnouncement{}, err
+	}
+	if !found {
+		return types.StorageAnnouncement{}, types.ErrInvalidOriginDomain.Wrapf("domain %d not registered", a.OriginDomain)
+	}
+	pubKey, storageURI := meta.CheckpointConfigFor(a.OriginDomain)
+	return a.Verify(domain, pubKey, storageURI)
+}
+
+// AddStorageAnnouncement appends a verified announcement to the storage
+// locations its checkpoint address announced for the domain. A location that
+// was already announced is not added again and added is false.
+func (k Keeper) AddStorageAnnouncement(ctx sdk.Context, a types.StorageAnnouncement) (added bool, err error) {
+	addr, err := types.DecodeHex(a.Validator)
+	if err != nil {
+		return false, err
+	}
+	var (
+		seq       uint64
+		announced bool
+	)
+	rng := collections.NewSuperPrefixedTripleRange[uint32, []byte, uint64](a.OriginDomain, addr)
+	err = k.StorageAnnouncements.Walk(ctx, rng, func(_ collections.Triple[uint32, []byte, uint64], existing types.StorageAnnouncement) (bool, error) {
+		announced = existing.StorageLocation == a.StorageLocation
+		seq++
+		return announced, nil
+	})
+	if err != nil || announced {
+		return false, err
+	}
+	return true, k.StorageAnnouncements.Set(ctx, collections.Join3(a.OriginDomain, addr, seq), a)
+}
+
+// GetAnnouncedStorageLocations returns every storage location a 20-byte
+// checkpoint address announced for originDomain, oldest first.
+func (k Keeper) GetAnnouncedStorageLocations(ctx sdk.Context, originDomain uint32, addr []byte) ([]string, error) {
+	locations := []string{}
+	rng := collections.NewSuperPrefixedTripleRange[uint32, []byte, uint64](originDomain, addr)
+	err := k.StorageAnnouncements.Walk(ctx, rng, func(_ collections.Triple[uint32, []byte, uint64], a types.StorageAnnouncement) (bool, error) {
+		locations = append(locations, a.StorageLocation)
+		return false, nil
+	})
+	return locations, err
+}
+
+// moveStorageAnnouncements moves the announcements DutyMetadata held before
+// v6 into StorageAnnouncements.
+func (k Keeper) moveStorageAnnouncements(ctx sdk.Context) error {
+	var (
+		consAddrs []sdk.ConsAddress
+		metas     []types.DutyMetadata
+	)
+	err := k.IterateDutyMetadata(ctx, func(valConsAddr sdk.ConsAddress, meta types.DutyMetadata) bool {
+		if len(meta.Announcements) > 0 {
+			consAddrs = append(consAddrs, valConsAddr)
+			metas = append(metas, meta)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	for i, meta := range metas {
+		for _, a := range meta.Announcements {
+			if a == nil {
+				continue
+			}
+			if _, err := k.AddStorageAnnouncement(ctx, *a); err != nil {
+				return err
+			}
+		}
+		meta.Announcements = nil
+		if err := k.DutyMetadata.Set(ctx, consAddrs[i], meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// HandleCheckpointEquivocation verifies that both checkpoints commit to the
+// same origin domain, merkle tree hook and index with different digests and
+// were signed by the same registered checkpoint key. If so, it slashes the
+// owning validator by the double-sign fraction, jails it permanently and
+// tombstones it. The same checkpoint signed twice, or two checkpoints for
+// different positions, are not evidence and are rejected.
+func (k Keeper) HandleCheckpointEquivocation(ctx sdk.Context, msg *types.MsgSubmitCheckpointEquivocation) (sdk.ConsAddress, error) {
+	if msg.First.OriginDomain != msg.Second.OriginDomain || msg.First.Index != msg.Second.Index {
+		return nil, types.ErrInvalidEquivocation.Wrap("checkpoints are for different origin domains or indexes")
+	}
+	if types.NormalizeHex(msg.First.MerkleTreeHook) != types.NormalizeHex(msg.Second.MerkleTreeHook) {
+		return nil, types.ErrInvalidEquivocation.Wrap("checkpoints are for different merkle tree hooks")
+	}
+	firstDigest, err := msg.First.Digest()
+	if err != nil {
+		return nil, err
+	}
+	secondDigest, err := msg.Second.Digest()
+	if err != nil {
+		return nil, err
+	}
+	if string(firstDigest) == string(secondDigest) {
+		return nil, types.ErrInvalidEquivocation.Wrap("checkpoints are identical")
+	}
+	first, err := msg.First.RecoverSigner()
+	if err != nil {
+		return nil, err
+	}
+	second, err := msg.Second.RecoverSigner()
+	if err != nil {
+		return nil, err
+	}
+	if string(first) != string(second) {
+		return nil, types.ErrInvalidEquivocation.Wrapf("signers differ: 0x%x != 0x%x", first, second)
+	}
+
+	consAddr, found, err := k.GetConsAddrByCheckpointAddress(ctx, first)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrInvalidEquivocation.Wrapf("no validator with checkpoint key 0x%x", first)
+	}
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return nil, types.ErrInvalidEquivocation.Wrapf("validator %s already tombstoned", consAddr)
+	}
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return nil, types.ErrInvalidEquivocation.Wrapf("no validator for %s: %s", consAddr, err)
+	}
+
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	slashFraction, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := k.stakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, slashFraction); err != nil {
+		return nil, err
+	}
+	if !validator.IsJailed() {
+		if err := k.stakingKeeper.Jail(ctx, consAddr); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.slashingKeeper.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime); err != nil {
+		return nil, err
+	}
+	if err := k.slashingKeeper.Tombstone(ctx, consAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("duty_checkpoint_equivocation",
+			sdk.NewAttribute("cons_addr", consAddr.String()),
+			sdk.NewAttribute("val_addr", validator.GetOperator()),
+			sdk.NewAttribute("checkpoint_signer", fmt.Sprintf("0x%x", first)),
+			sdk.NewAttribute("origin_domain", fmt.Sprintf("%d", msg.First.OriginDomain)),
+			sdk.NewAttribute("index", fmt.Sprintf("%d", msg.First.Index)),
+			sdk.NewAttribute("first_root", types.NormalizeHex(msg.First.Root)),
+			sdk.NewAttribute("second_root", types.NormalizeHex(msg.Second.Root)),
+			sdk.NewAttribute("power", fmt.Sprintf("%d", power)),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	return consAddr, nil
+}
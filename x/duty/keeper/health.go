@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// GetDutySetHealth measures how much of the live bonded power is held by
+// validators whose metadata can actually be used to sign checkpoints, and
+// whether that is enough to reach quorum.
+func (k Keeper) GetDutySetHealth(ctx sdk.Context) (types.DutySetHealth, error) {
+	set, params, err := k.GetDutySet(ctx)
+	if err != nil {
+		return types.DutySetHealth{}, err
+	}
+
+	total, valid, missing, invalid := math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt()
+	var validCount uint32
+	for _, dv := range set {
+		power, ok := math.NewIntFromString(dv.VotingPower)
+		if !ok {
+			power = math.ZeroInt()
+		}
+		total = total.Add(power)
+
+		switch {
+		case dv.Metadata == nil:
+			missing = missing.Add(power)
+		case !validDutyMetadata(*dv.Metadata):
+			invalid = invalid.Add(power)
+		default:
+			valid = valid.Add(power)
+			validCount++
+		}
+	}
+
+	count := uint32(len(set))
+	threshold := types.QuorumPowerThreshold(total, params.QuorumNumerator, params.QuorumDenominator)
+	achievable := types.QuorumReached(valid, total, params.QuorumNumerator, params.QuorumDenominator)
+	if params.QuorumMode == types.QuorumMode_QUORUM_MODE_COUNT {
+		achievable = types.QuorumReached(math.NewInt(int64(validCount)), math.NewInt(int64(count)), params.QuorumNumerator, params.QuorumDenominator)
+	}
+	return types.DutySetHealth{
+		TotalPower:           total.String(),
+		ValidMetadataPower:   valid.String(),
+		MissingMetadataPower: missing.String(),
+		InvalidMetadataPower: invalid.String(),
+		QuorumThresholdPower: threshold.String(),
+		QuorumAchievable:     achievable,
+		ValidatorCount:       count,
+		ValidMetadataCount:   validCount,
+		QuorumNum:            params.QuorumNumerator,
+		QuorumDen:            params.QuorumDenominator,
+		QuorumMode:           params.QuorumMode,
+		QuorumThresholdCount: types.QuorumCountThreshold(count, params.QuorumNumerator, params.QuorumDenominator),
+	}, nil
+}
+
+func validDutyMetadata(meta types.DutyMetadata) bool {
+	if meta.CheckpointStorageUri == "" {
+		return false
+	}
+	_, err := types.CheckpointAddress(meta.CheckpointPubKey)
+	return err == nil
+}
+
+// checkQuorumCoverage emits duty_quorum_coverage_low when the power (or, in
+// count mode, the number of validators) with valid metadata drops below
+// quorum, and duty_quorum_coverage_restored when it recovers. Nothing is
+// emitted while the state is unchanged.
+func (k Keeper) checkQuorumCoverage(ctx sdk.Context) error {
+	health, err := k.GetDutySetHealth(ctx)
+	if err != nil {
+		return err
+	}
+	wasLow, _, err := lookup(k.QuorumCoverageLow.Get(ctx))
+	if err != nil {
+		return err
+	}
+	isLow := !health.QuorumAchievable
+	if isLow == wasLow {
+		return nil
+	}
+
+	eventType := "duty_quorum_coverage_restored"
+	if isLow {
+		eventType = "duty_quorum_coverage_low"
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(eventType,
+			sdk.NewAttribute("total_power", health.TotalPower),
+			sdk.NewAttribute("valid_metadata_power", health.ValidMetadataPower),
+			sdk.NewAttribute("quorum_threshold_power", health.QuorumThresholdPower),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+	return k.QuorumCoverageLow.Set(ctx, isLow)
+}
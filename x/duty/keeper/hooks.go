@@ -1,25 +1,31 @@
 package keeper
 
 import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// We don't need to write anything on-chain for updates; we emit events
-// so off-chain indexers / agents can react.
+// Staking updates only emit events so off-chain indexers / agents can react,
+// except removal, which archives the validator's duty metadata.
 type DutyHooks struct{ k Keeper }
 
 var _ stakingtypes.StakingHooks = DutyHooks{}
 
-func (h DutyHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+func (h DutyHooks) AfterValidatorBonded(goCtx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	// Get validator info for more detailed event
-	validator, found := h.k.stakingKeeper.GetValidator(ctx, valAddr)
-	if found {
+	validator, err := h.k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err == nil {
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent("duty_validator_bonded",
 				sdk.NewAttribute("cons_addr", consAddr.String()),
 				sdk.NewAttribute("val_addr", valAddr.String()),
-				sdk.NewAttribute("voting_power", validator.GetTokens().String()),
+				sdk.NewAttribute("voting_power", fmt.Sprintf("%d", validator.GetConsensusPower(h.k.stakingKeeper.PowerReduction(ctx)))),
+				sdk.NewAttribute("tokens", validator.GetTokens().String()),
 				sdk.NewAttribute("moniker", validator.GetMoniker()),
 			),
 		)
@@ -31,30 +37,70 @@ func (h DutyHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddres
 			),
 		)
 	}
+	return nil
 }
 
-func (h DutyHooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+func (h DutyHooks) AfterValidatorRemoved(goCtx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent("duty_validator_removed",
 			sdk.NewAttribute("cons_addr", consAddr.String()),
 			sdk.NewAttribute("val_addr", valAddr.String()),
 		),
 	)
-}
 
-func (h DutyHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if err := h.k.ValidatorConsAddrs.Remove(ctx, valAddr); err != nil {
+		h.k.logger.Error("failed to stop tracking consensus address", "val_addr", valAddr.String(), "err", err)
+	}
+
+	// Archive in a cache context so a failure leaves no partial state
+	cacheCtx, write := ctx.CacheContext()
+	tombstone, archived, err := h.k.ArchiveDutyMetadata(cacheCtx, consAddr, valAddr)
+	if err != nil {
+		h.k.logger.Error("failed to archive duty metadata", "cons_addr", consAddr.String(), "err", err)
+		return nil
+	}
+	if !archived {
+		return nil
+	}
+	write()
 	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("duty_metadata_archived",
+			sdk.NewAttribute("cons_addr", consAddr.String()),
+			sdk.NewAttribute("val_addr", valAddr.String()),
+			sdk.NewAttribute("checkpoint_pub_key", tombstone.Metadata.CheckpointPubKey),
+			sdk.NewAttribute("removal_height", fmt.Sprintf("%d", tombstone.RemovalHeight)),
+		),
+	)
+	return nil
+}
+
+func (h DutyHooks) AfterValidatorBeginUnbonding(goCtx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
 		sdk.NewEvent("duty_validator_unbonding",
 			sdk.NewAttribute("cons_addr", consAddr.String()),
 			sdk.NewAttribute("val_addr", valAddr.String()),
 		),
 	)
+	return nil
 }
 
 // Implement other hooks as no-ops for brevity
-func (h DutyHooks) AfterValidatorCreated(sdk.Context, sdk.ValAddress)                          {}
-func (h DutyHooks) BeforeValidatorModified(sdk.Context, sdk.ValAddress)                        {}
-func (h DutyHooks) BeforeDelegationCreated(sdk.Context, sdk.ValAddress, sdk.AccAddress)        {}
-func (h DutyHooks) AfterDelegationModified(sdk.Context, sdk.ValAddress, sdk.AccAddress)        {}
-func (h DutyHooks) BeforeDelegationSharesModified(sdk.Context, sdk.ValAddress, sdk.AccAddress) {}
-func (h DutyHooks) AfterUnbondingInitiated(sdk.Context, uint64)                                {}
+func (h DutyHooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error   { return nil }
+func (h DutyHooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error { return nil }
+func (h DutyHooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+func (h DutyHooks) BeforeDelegationSharesModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+func (h DutyHooks) BeforeDelegationRemoved(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+func (h DutyHooks) AfterDelegationModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+func (h DutyHooks) BeforeValidatorSlashed(context.Context, sdk.ValAddress, math.LegacyDec) error {
+	return nil
+}
+func (h DutyHooks) AfterUnbondingInitiated(context.Context, uint64) error { return nil }
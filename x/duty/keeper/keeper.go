@@ -2,137 +2,442 @@ package keeper
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
-	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
-	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/TheArticulation/Duty/x/duty/types"
 )
 
+// DutyMetadataIndexes are the secondary indexes over DutyMetadata.
+type DutyMetadataIndexes struct {
+	// CheckpointAddress maps the 20-byte address of a checkpoint key, default,
+	// per-domain, pending or previous, to the validator that registered it,
+	// enforcing that a key has one owner. Keys that do not parse are not
+	// indexed.
+	CheckpointAddress *indexes.Unique[[]byte, sdk.ConsAddress, types.DutyMetadata]
+}
+
+func (i DutyMetadataIndexes) IndexesList() []collections.Index[sdk.ConsAddress, types.DutyMetadata] {
+	return []collections.Index[sdk.ConsAddress, types.DutyMetadata]{checkpointAddressIndex{i.CheckpointAddress}}
+}
+
+// checkpointAddressIndex maintains CheckpointAddress for every key of the
+// metadata, skipping keys that do not parse instead of failing the write.
+type checkpointAddressIndex struct {
+	*indexes.Unique[[]byte, sdk.ConsAddress, types.DutyMetadata]
+}
+
+func (i checkpointAddressIndex) Reference(ctx context.Context, pk sdk.ConsAddress, newValue types.DutyMetadata, lazyOldValue func() (types.DutyMetadata, error)) error {
+	if err := i.Unreference(ctx, pk, lazyOldValue); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	for _, key := range newValue.CheckpointPubKeys() {
+		err := i.Unique.Reference(ctx, pk, types.DutyMetadata{CheckpointPubKey: key}, func() (types.DutyMetadata, error) {
+			return types.DutyMetadata{}, collections.ErrNotFound
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i checkpointAddressIndex) Unreference(ctx context.Context, pk sdk.ConsAddress, lazyOldValue func() (types.DutyMetadata, error)) error {
+	oldValue, err := lazyOldValue()
+	if err != nil {
+		return err
+	}
+	for _, key := range oldValue.CheckpointPubKeys() {
+		err := i.Unique.Unreference(ctx, pk, func() (types.DutyMetadata, error) {
+			return types.DutyMetadata{CheckpointPubKey: key}, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newDutyMetadataIndexes(sb *collections.SchemaBuilder) DutyMetadataIndexes {
+	return DutyMetadataIndexes{
+		CheckpointAddress: indexes.NewUnique(
+			sb, types.CheckpointAddressPrefix, "checkpoint_address",
+			collections.BytesKey, sdk.ConsAddressKey,
+			func(_ sdk.ConsAddress, meta types.DutyMetadata) ([]byte, error) {
+				return types.CheckpointAddress(meta.CheckpointPubKey)
+			},
+		),
+	}
+}
+
 type Keeper struct {
-	cdc           codec.Codec
-	storeService  store.KVStoreService
-	paramSpace    paramtypes.Subspace
-	stakingKeeper *stakingkeeper.Keeper
-	logger        log.Logger
-	paramsService types.ParamsService
+	cdc            codec.Codec
+	storeService   store.KVStoreService
+	stakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
+	logger         log.Logger
+	// authority is the address allowed to execute MsgUpdateParams and the
+	// origin domain registry messages, normally the gov module account
+	authority string
+
+	Schema                 collections.Schema
+	Params                 collections.Item[types.Params]
+	DutyMetadata           *collections.IndexedMap[sdk.ConsAddress, types.DutyMetadata, DutyMetadataIndexes]
+	DutyNonces             collections.Map[sdk.ConsAddress, uint64]
+	DutySetSnapshots       collections.Map[uint64, types.DutySetSnapshot] // keyed by height
+	DutySetEpochs          collections.Map[uint64, uint64]                // epoch -> height
+	Checkpoints            collections.Map[collections.Triple[uint32, uint32, []byte], types.Checkpoint]
+	CheckpointSigningInfos collections.Map[sdk.ConsAddress, types.CheckpointSigningInfo]
+	CheckpointMissed       collections.KeySet[collections.Pair[sdk.ConsAddress, uint64]]
+	QuorumCoverageLow      collections.Item[bool]
+	OriginDomains          collections.Map[uint32, types.OriginDomain]
+	// PendingKeyRotations orders validators with a pending checkpoint key by
+	// activation height, so EndBlock only visits rotations that are due.
+	PendingKeyRotations collections.KeySet[collections.Pair[int64, sdk.ConsAddress]]
+	// Tombstones archive the metadata of removed validators;
+	// TombstoneCheckpointAddresses indexes them by checkpoint address. A key
+	// may appear in several tombstones once it has been released and reused.
+	Tombstones                   collections.Map[sdk.ConsAddress, types.DutyMetadataTombstone]
+	TombstoneCheckpointAddresses collections.KeySet[collections.Pair[[]byte, sdk.ConsAddress]]
+	// ConsensusKeyMigrations map a rotated-away consensus address to the one
+	// its duty state moved to; ConsensusKeyMigrationsByNew is the reverse.
+	ConsensusKeyMigrations      collections.Map[sdk.ConsAddress, types.ConsensusKeyMigration]
+	ConsensusKeyMigrationsByNew collections.KeySet[collections.Pair[sdk.ConsAddress, sdk.ConsAddress]]
+	// CheckpointLivenessQueue orders quorum checkpoints by liveness deadline,
+	// so EndBlock only visits checkpoints whose grace period has ended.
+	CheckpointLivenessQueue collections.Map[collections.Pair[int64, []byte], collections.Pair[uint32, uint32]]
+	// ValidatorConsAddrs maps an operator to the consensus address its duty
+	// state is stored under, so EndBlock can detect consensus key rotations.
+	ValidatorConsAddrs collections.Map[sdk.ValAddress, sdk.ConsAddress]
+	// StorageAnnouncements keep every verified storage announcement of a
+	// checkpoint address for an origin domain, append-only like
+	// ValidatorAnnounce.
+	StorageAnnouncements collections.Map[collections.Triple[uint32, []byte, uint64], types.StorageAnnouncement]
 }
 
 func NewKeeper(
 	cdc codec.Codec,
 	storeService store.KVStoreService,
-	ps paramtypes.Subspace,
-	stakingKeeper *stakingkeeper.Keeper,
+	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
 	logger log.Logger,
-	paramsService types.ParamsService,
+	authority string,
 ) Keeper {
-	if !ps.HasKeyTable() {
-		ps = ps.WithKeyTable(types.ParamKeyTable())
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:            cdc,
+		storeService:   storeService,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+		logger:         logger,
+		authority:      authority,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DutyMetadata: collections.NewIndexedMap(
+			sb, types.DutyMetaPrefix, "duty_metadata",
+			sdk.ConsAddressKey, codec.CollValue[types.DutyMetadata](cdc),
+			newDutyMetadataIndexes(sb),
+		),
+		DutyNonces: collections.NewMap(
+			sb, types.DutyNoncePrefix, "duty_nonces",
+			sdk.ConsAddressKey, collections.Uint64Value,
+		),
+		DutySetSnapshots: collections.NewMap(
+			sb, types.DutySetSnapshotPrefix, "duty_set_snapshots",
+			collections.Uint64Key, codec.CollValue[types.DutySetSnapshot](cdc),
+		),
+		DutySetEpochs: collections.NewMap(
+			sb, types.DutySetEpochPrefix, "duty_set_epochs",
+			collections.Uint64Key, collections.Uint64Value,
+		),
+		Checkpoints: collections.NewMap(
+			sb, types.CheckpointPrefix, "checkpoints",
+			collections.TripleKeyCodec(collections.Uint32Key, collections.Uint32Key, collections.BytesKey),
+			codec.CollValue[types.Checkpoint](cdc),
+		),
+		CheckpointSigningInfos: collections.NewMap(
+			sb, types.CheckpointSigningInfoPrefix, "checkpoint_signing_infos",
+			sdk.ConsAddressKey, codec.CollValue[types.CheckpointSigningInfo](cdc),
+		),
+		CheckpointMissed: collections.NewKeySet(
+			sb, types.CheckpointMissedPrefix, "checkpoint_missed",
+			collections.PairKeyCodec(sdk.ConsAddressKey, collections.Uint64Key),
+		),
+		QuorumCoverageLow: collections.NewItem(sb, types.QuorumCoverageLowKey, "quorum_coverage_low", collections.BoolValue),
+		OriginDomains: collections.NewMap(
+			sb, types.OriginDomainPrefix, "origin_domains",
+			collections.Uint32Key, codec.CollValue[types.OriginDomain](cdc),
+		),
+		PendingKeyRotations: collections.NewKeySet(
+			sb, types.PendingKeyRotationPrefix, "pending_key_rotations",
+			collections.PairKeyCodec(collections.Int64Key, sdk.ConsAddressKey),
+		),
+		Tombstones: collections.NewMap(
+			sb, types.DutyMetadataTombstonePrefix, "duty_metadata_tombstones",
+			sdk.ConsAddressKey, codec.CollValue[types.DutyMetadataTombstone](cdc),
+		),
+		TombstoneCheckpointAddresses: collections.NewKeySet(
+			sb, types.TombstoneCheckpointAddressPrefix, "tombstone_checkpoint_addresses",
+			collections.PairKeyCodec(collections.BytesKey, sdk.ConsAddressKey),
+		),
+		ConsensusKeyMigrations: collections.NewMap(
+			sb, types.ConsensusKeyMigrationPrefix, "consensus_key_migrations",
+			sdk.ConsAddressKey, codec.CollValue[types.ConsensusKeyMigration](cdc),
+		),
+		ConsensusKeyMigrationsByNew: collections.NewKeySet(
+			sb, types.ConsensusKeyMigrationByNewPrefix, "consensus_key_migrations_by_new",
+			collections.PairKeyCodec(sdk.ConsAddressKey, sdk.ConsAddressKey),
+		),
+		CheckpointLivenessQueue: collections.NewMap(
+			sb, types.CheckpointLivenessQueuePrefix, "checkpoint_liveness_queue",
+			collections.PairKeyCodec(collections.Int64Key, collections.BytesKey),
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Uint32Key, collections.Uint32Key)),
+		),
+		ValidatorConsAddrs: collections.NewMap(
+			sb, types.ValidatorConsAddrPrefix, "validator_cons_addrs",
+			sdk.ValAddressKey, collcodec.KeyToValueCodec(sdk.ConsAddressKey),
+		),
+		StorageAnnouncements: collections.NewMap(
+			sb, types.StorageAnnouncementPrefix, "storage_announcements",
+			collections.TripleKeyCodec(collections.Uint32Key, collections.BytesKey, collections.Uint64Key),
+			codec.CollValue[types.StorageAnnouncement](cdc),
+		),
 	}
-	return Keeper{
-		cdc:           cdc,
-		storeService:  storeService,
-		paramSpace:    ps,
-		stakingKeeper: stakingKeeper,
-		logger:        logger,
-		paramsService: paramsService,
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+	return k
 }
 
-// Set & Get params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	// Try to get params from the modern params service first
-	if k.paramsService != nil {
-		if params, err := k.getParamsFromService(ctx); err == nil {
-			return params
-		}
+// lookup turns collections.ErrNotFound into found == false, so callers only
+// see errors for state that exists but cannot be read.
+func lookup[V any](v V, err error) (V, bool, error) {
+	var zero V
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return zero, false, nil
+	case err != nil:
+		return zero, false, err
 	}
+	return v, true, nil
+}
 
-	// Fallback to legacy param space
-	var p types.Params
-	k.paramSpace.GetParamSet(ctx, &p)
-	return p
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
-func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
-	// Try to set params using the modern params service first
-	if k.paramsService != nil {
-		if err := k.setParamsToService(ctx, p); err == nil {
-			return
+// Set & Get params
+
+// GetParams returns the module params. The params item is the only source:
+// InitGenesis writes it, and the v3 migration copies legacy values into it.
+func (k Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// SetParams validates and writes the params item.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	return k.Params.Set(ctx, p)
+}
+
+// Duty metadata CRUD
+
+// SetDutyMetadata stores a validator's metadata; the collection keeps the
+// checkpoint address index in sync. The keys are written as given, so callers
+// must have proven them (MsgBindCheckpointKey, MsgRotateCheckpointKey) or
+// trust them (genesis, migrations); MsgSetDutyMetadata only changes storage
+// URIs. It fails if another validator already registered one of the
+// checkpoint keys, default, per-domain, pending or previous. The pending
+// key, if any, is scheduled for activation.
+func (k Keeper) SetDutyMetadata(ctx sdk.Context, valConsAddr sdk.ConsAddress, meta types.DutyMetadata) error {
+	for _, key := range meta.CheckpointPubKeys() {
+		if err := k.claimCheckpointKey(ctx, valConsAddr, key); err != nil {
+			return err
 		}
 	}
+	existing, found, err := k.GetDutyMetadata(ctx, valConsAddr)
+	if err != nil {
+		return err
+	}
+	if found && existing.PendingKey != nil {
+		if err := k.PendingKeyRotations.Remove(ctx, collections.Join(existing.PendingKey.ActivationHeight, valConsAddr)); err != nil {
+			return err
+		}
+	}
+	if meta.PendingKey != nil {
+		if err := k.PendingKeyRotations.Set(ctx, collections.Join(meta.PendingKey.ActivationHeight, valConsAddr)); err != nil {
+			return err
+		}
+	}
+	return k.DutyMetadata.Set(ctx, valConsAddr, meta)
+}
 
-	// Fallback to legacy param space
-	k.paramSpace.SetParamSet(ctx, &p)
+// GetDutyMetadata returns a validator's metadata. found is false if none is
+// stored; an error means the stored entry could not be decoded.
+func (k Keeper) GetDutyMetadata(ctx sdk.Context, valConsAddr sdk.ConsAddress) (types.DutyMetadata, bool, error) {
+	return lookup(k.DutyMetadata.Get(ctx, valConsAddr))
 }
 
-// getParamsFromService retrieves parameters from the modern params service
-func (k Keeper) getParamsFromService(ctx sdk.Context) (types.Params, error) {
-	if k.paramsService == nil {
-		return types.DefaultParams(), fmt.Errorf("params service not available")
-	}
+// IterateDutyMetadata calls cb for every stored metadata entry until cb
+// returns true.
+func (k Keeper) IterateDutyMetadata(ctx sdk.Context, cb func(valConsAddr sdk.ConsAddress, meta types.DutyMetadata) (stop bool)) error {
+	return k.DutyMetadata.Walk(ctx, nil, func(valConsAddr sdk.ConsAddress, meta types.DutyMetadata) (bool, error) {
+		return cb(valConsAddr, meta), nil
+	})
+}
 
-	// Convert sdk.Context to context.Context for the service
-	serviceCtx := context.Background()
-	return k.paramsService.GetParams(serviceCtx)
+// claimCheckpointKey checks that no other validator registered key. Keys that
+// do not parse cannot sign anything, so they are not indexed and never
+// conflict.
+func (k Keeper) claimCheckpointKey(ctx sdk.Context, valConsAddr sdk.ConsAddress, key string) error {
+	addr, err := types.CheckpointAddress(key)
+	if err != nil {
+		return nil
+	}
+	owner, found, err := k.GetConsAddrByCheckpointAddress(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if found && !owner.Equals(valConsAddr) {
+		return types.ErrCheckpointKeyInUse.Wrapf("0x%x is registered by %s", addr, owner)
+	}
+	return nil
 }
 
-// setParamsToService sets parameters using the modern params service
-func (k Keeper) setParamsToService(ctx sdk.Context, params types.Params) error {
-	if k.paramsService == nil {
-		return fmt.Errorf("params service not available")
+// rebuildCheckpointAddressIndex indexes the checkpoint keys of every stored
+// metadata entry that are not indexed yet. Metadata written before the index
+// existed may share a key: the validator visited first keeps it and the
+// others are logged and left unindexed.
+func (k Keeper) rebuildCheckpointAddressIndex(ctx sdk.Context) error {
+	var (
+		consAddrs []sdk.ConsAddress
+		metas     []types.DutyMetadata
+	)
+	err := k.IterateDutyMetadata(ctx, func(valConsAddr sdk.ConsAddress, meta types.DutyMetadata) bool {
+		consAddrs = append(consAddrs, valConsAddr)
+		metas = append(metas, meta)
+		return false
+	})
+	if err != nil {
+		return err
 	}
 
-	// Convert sdk.Context to context.Context for the service
-	serviceCtx := context.Background()
-	return k.paramsService.SetParams(serviceCtx, params)
+	index := k.DutyMetadata.Indexes.CheckpointAddress
+	for i, valConsAddr := range consAddrs {
+		for _, key := range metas[i].CheckpointPubKeys() {
+			addr, _ := types.CheckpointAddress(key)
+			owner, found, err := k.GetConsAddrByCheckpointAddress(ctx, addr)
+			if err != nil {
+				return err
+			}
+			if found {
+				if !owner.Equals(valConsAddr) {
+					k.logger.Error("checkpoint key shared by several validators; not indexed", "key", key, "owner", owner.String(), "val_cons_addr", valConsAddr.String())
+				}
+				continue
+			}
+			err = index.Reference(ctx, valConsAddr, types.DutyMetadata{CheckpointPubKey: key}, func() (types.DutyMetadata, error) {
+				return types.DutyMetadata{}, collections.ErrNotFound
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
-// Duty metadata CRUD
-func (k Keeper) SetDutyMetadata(ctx sdk.Context, valConsAddr sdk.ConsAddress, meta types.DutyMetadata) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz, _ := json.Marshal(meta)
-	store.Set(types.DutyMetaKey(valConsAddr.Bytes()), bz)
+// GetConsAddrByCheckpointAddress returns the validator whose checkpoint key
+// has the given 20-byte Ethereum address.
+func (k Keeper) GetConsAddrByCheckpointAddress(ctx sdk.Context, checkpointAddr []byte) (sdk.ConsAddress, bool, error) {
+	return lookup(k.DutyMetadata.Indexes.CheckpointAddress.MatchExact(ctx, checkpointAddr))
+}
+
+// GetDutyNonce returns the number of duty metadata messages accepted for a
+// validator. Every MsgSetDutyMetadata, MsgRotateCheckpointKey and
+// MsgBindCheckpointKey must carry it, and the rotation and binding
+// signatures commit to it, so an old message or signature cannot be
+// replayed.
+func (k Keeper) GetDutyNonce(ctx sdk.Context, valConsAddr sdk.ConsAddress) (uint64, error) {
+	nonce, _, err := lookup(k.DutyNonces.Get(ctx, valConsAddr))
+	return nonce, err
+}
+
+// SetDutyNonce sets a validator's duty nonce (used by genesis import).
+func (k Keeper) SetDutyNonce(ctx sdk.Context, valConsAddr sdk.ConsAddress, nonce uint64) error {
+	if nonce == 0 {
+		return k.DutyNonces.Remove(ctx, valConsAddr)
+	}
+	return k.DutyNonces.Set(ctx, valConsAddr, nonce)
 }
-func (k Keeper) GetDutyMetadata(ctx sdk.Context, valConsAddr sdk.ConsAddress) (types.DutyMetadata, bool) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz := store.Get(types.DutyMetaKey(valConsAddr.Bytes()))
-	if bz == nil {
-		return types.DutyMetadata{}, false
+
+// useDutyNonce checks that nonce is the validator's current duty nonce and
+// advances it.
+func (k Keeper) useDutyNonce(ctx sdk.Context, valConsAddr sdk.ConsAddress, nonce uint64) error {
+	current, err := k.GetDutyNonce(ctx, valConsAddr)
+	if err != nil {
+		return err
 	}
-	var dm types.DutyMetadata
-	_ = json.Unmarshal(bz, &dm)
-	return dm, true
+	if nonce != current {
+		return types.ErrInvalidNonce.Wrapf("got %d, expected %d", nonce, current)
+	}
+	return k.SetDutyNonce(ctx, valConsAddr, current+1)
 }
 
 // DutySet view: expose current consensus validators with optional metadata
 type DutyValidator struct {
 	ValConsAddr string              `json:"val_cons_addr"`
-	VotingPower string              `json:"voting_power"` // string to avoid precision issues in JSON
+	VotingPower string              `json:"voting_power"` // consensus power; string to avoid precision issues in JSON
+	Tokens      string              `json:"tokens"`
 	Metadata    *types.DutyMetadata `json:"metadata,omitempty"`
 }
 
-func (k Keeper) GetDutySet(ctx sdk.Context) ([]DutyValidator, types.Params) {
-	vals := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+func (k Keeper) GetDutySet(ctx sdk.Context) ([]DutyValidator, types.Params, error) {
+	vals, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return nil, types.Params{}, err
+	}
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
 	out := make([]DutyValidator, 0, len(vals))
 	for _, v := range vals {
-		consAddr, _ := v.GetConsAddr()
+		bz, err := v.GetConsAddr()
+		if err != nil {
+			return nil, types.Params{}, err
+		}
+		consAddr := sdk.ConsAddress(bz)
 		dv := DutyValidator{
 			ValConsAddr: consAddr.String(),
-			VotingPower: v.GetTokens().String(),
+			VotingPower: fmt.Sprintf("%d", v.GetConsensusPower(powerReduction)),
+			Tokens:      v.GetTokens().String(),
+		}
+		meta, ok, err := k.GetDutyMetadata(ctx, consAddr)
+		if err != nil {
+			return nil, types.Params{}, err
 		}
-		if meta, ok := k.GetDutyMetadata(ctx, consAddr); ok {
+		if ok {
 			dv.Metadata = &meta
 		}
 		out = append(out, dv)
 	}
-	return out, k.GetParams(ctx)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, types.Params{}, err
+	}
+	return out, params, nil
 }
 
 // NewDutyHooks creates a new DutyHooks instance
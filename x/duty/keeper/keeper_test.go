@@ -1,51 +1,148 @@
 package keeper
 
 import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/store"
-	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/tendermint/tendermint/libs/log"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
-	tmdb "github.com/tendermint/tm-db"
 
 	"github.com/TheArticulation/Duty/x/duty/types"
 )
 
+// mockStakingKeeper is an in-memory types.StakingKeeper holding a fixed
+// validator set and recording slashed validators.
+type mockStakingKeeper struct {
+	validators []stakingtypes.Validator
+	slashed    map[string]bool
+}
+
+func newMockStakingKeeper(validators ...stakingtypes.Validator) *mockStakingKeeper {
+	return &mockStakingKeeper{validators: validators, slashed: map[string]bool{}}
+}
+
+func (m *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	for _, v := range m.validators {
+		if v.GetOperator() == addr.String() {
+			return v, nil
+		}
+	}
+	return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+}
+
+func (m *mockStakingKeeper) GetValidatorByConsAddr(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+	for _, v := range m.validators {
+		bz, err := v.GetConsAddr()
+		if err == nil && consAddr.Equals(sdk.ConsAddress(bz)) {
+			return v, nil
+		}
+	}
+	return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+}
+
+func (m *mockStakingKeeper) GetBondedValidatorsByPower(context.Context) ([]stakingtypes.Validator, error) {
+	return m.validators, nil
+}
+
+func (m *mockStakingKeeper) PowerReduction(context.Context) math.Int {
+	return sdk.DefaultPowerReduction
+}
+
+func (m *mockStakingKeeper) Slash(_ context.Context, consAddr sdk.ConsAddress, _, _ int64, _ math.LegacyDec) (math.Int, error) {
+	m.slashed[consAddr.String()] = true
+	return math.ZeroInt(), nil
+}
+
+func (m *mockStakingKeeper) Jail(context.Context, sdk.ConsAddress) error {
+	return nil
+}
+
+// mockSlashingKeeper is an in-memory types.SlashingKeeper that records
+// tombstoned validators.
+type mockSlashingKeeper struct {
+	tombstoned map[string]bool
+}
+
+func newMockSlashingKeeper() *mockSlashingKeeper {
+	return &mockSlashingKeeper{tombstoned: map[string]bool{}}
+}
+
+func (m *mockSlashingKeeper) IsTombstoned(_ context.Context, consAddr sdk.ConsAddress) bool {
+	return m.tombstoned[consAddr.String()]
+}
+
+func (m *mockSlashingKeeper) SlashFractionDoubleSign(context.Context) (math.LegacyDec, error) {
+	return math.LegacyNewDecWithPrec(5, 2), nil
+}
+
+func (m *mockSlashingKeeper) JailUntil(context.Context, sdk.ConsAddress, time.Time) error {
+	return nil
+}
+
+func (m *mockSlashingKeeper) Tombstone(_ context.Context, consAddr sdk.ConsAddress) error {
+	m.tombstoned[consAddr.String()] = true
+	return nil
+}
+
 func setupTestKeeper(t *testing.T) (Keeper, sdk.Context) {
+	return setupTestKeeperWithStaking(t, newMockStakingKeeper())
+}
+
+func setupTestKeeperWithStaking(t *testing.T, stakingKeeper types.StakingKeeper) (Keeper, sdk.Context) {
 	// Create a test database
-	db := tmdb.NewMemDB()
+	db := dbm.NewMemDB()
 
 	// Create a test store
-	ms := store.NewCommitMultiStore(db)
-	ms.MountStoreWithDB(types.StoreKey, storetypes.StoreTypeIAVL, db)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, ms.LoadLatestVersion())
 
 	// Create a test context
-	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	ctx := sdk.NewContext(ms, cmtproto.Header{}, false, log.NewNopLogger())
 
 	// Create a test codec
-	cdc := codec.NewLegacyAmino()
-	types.RegisterLegacyAminoCodec(cdc)
-
-	// Create a test param space
-	paramSpace := paramtypes.NewSubspace(cdc, types.ModuleName, "duty", "duty")
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 
 	// Create a test keeper
 	keeper := NewKeeper(
 		cdc,
-		store.NewKVStoreService(ms.GetKVStore(types.StoreKey)),
-		paramSpace,
-		nil, // staking keeper (nil for test)
+		runtime.NewKVStoreService(storeKey),
+		stakingKeeper,
+		nil, // slashing keeper (nil for test)
 		log.NewNopLogger(),
-		nil, // params service (nil for test)
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Params are written by InitGenesis on a real chain
+	require.NoError(t, keeper.SetParams(ctx, types.DefaultParams()))
+
 	return keeper, ctx
 }
 
@@ -58,26 +155,28 @@ func TestKeeper_SetAndGetDutyMetadata(t *testing.T) {
 	// Create test metadata
 	metadata := types.DutyMetadata{
 		CheckpointPubKey:     "0x1234567890abcdef",
-		CheckpointStorageURI: "s3://bucket/prefix/",
+		CheckpointStorageUri: "s3://bucket/prefix/",
 	}
 
 	// Set metadata
-	keeper.SetDutyMetadata(ctx, consAddr, metadata)
+	require.NoError(t, keeper.SetDutyMetadata(ctx, consAddr, metadata))
 
 	// Get metadata
-	retrievedMetadata, found := keeper.GetDutyMetadata(ctx, consAddr)
+	retrievedMetadata, found, err := keeper.GetDutyMetadata(ctx, consAddr)
 
 	// Assertions
+	require.NoError(t, err)
 	assert.True(t, found)
 	assert.Equal(t, metadata.CheckpointPubKey, retrievedMetadata.CheckpointPubKey)
-	assert.Equal(t, metadata.CheckpointStorageURI, retrievedMetadata.CheckpointStorageURI)
+	assert.Equal(t, metadata.CheckpointStorageUri, retrievedMetadata.CheckpointStorageUri)
 }
 
 func TestKeeper_GetParams(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
 
 	// Get default params
-	params := keeper.GetParams(ctx)
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
 
 	// Assert default values
 	assert.Equal(t, uint32(2), params.QuorumNumerator)
@@ -88,42 +187,101 @@ func TestKeeper_SetParams(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
 
 	// Create custom params
-	customParams := types.Params{
-		QuorumNumerator:   3,
-		QuorumDenominator: 4,
-	}
+	customParams := types.DefaultParams()
+	customParams.QuorumNumerator = 3
+	customParams.QuorumDenominator = 4
 
 	// Set params
-	keeper.SetParams(ctx, customParams)
+	require.NoError(t, keeper.SetParams(ctx, customParams))
 
 	// Get params
-	retrievedParams := keeper.GetParams(ctx)
+	retrievedParams, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
 
 	// Assertions
 	assert.Equal(t, customParams.QuorumNumerator, retrievedParams.QuorumNumerator)
 	assert.Equal(t, customParams.QuorumDenominator, retrievedParams.QuorumDenominator)
 }
 
+func TestMsgServer_UpdateParams(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	msgServer := NewMsgServerImpl(keeper)
+
+	params := types.DefaultParams()
+	params.QuorumNumerator = 3
+	params.QuorumDenominator = 4
+	params.QuorumMode = types.QuorumMode_QUORUM_MODE_COUNT
+
+	// Only the authority may update params
+	other := sdk.AccAddress([]byte("not-the-authority---")).String()
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: other, Params: params})
+	assert.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// Params are validated in full
+	invalid := params
+	invalid.QuorumNumerator = 5
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: keeper.GetAuthority(), Params: invalid})
+	assert.Error(t, err)
+	stored, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultParams().QuorumNumerator, stored.QuorumNumerator)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: keeper.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	stored, err = keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, params.QuorumNumerator, stored.QuorumNumerator)
+	assert.Equal(t, types.QuorumMode_QUORUM_MODE_COUNT, stored.QuorumMode)
+
+	events := ctx.EventManager().Events()
+	require.NotEmpty(t, events)
+	assert.Equal(t, "duty_params_updated", events[len(events)-1].Type)
+}
+
 func TestKeeper_GetDutySet(t *testing.T) {
 	keeper, ctx := setupTestKeeper(t)
 
-	// Get duty set (without staking keeper, this will return empty list)
-	validators, params := keeper.GetDutySet(ctx)
+	// Get duty set (the mock staking keeper has no validators)
+	validators, params, err := keeper.GetDutySet(ctx)
+	require.NoError(t, err)
 
 	// Assertions
-	assert.Empty(t, validators) // No validators without staking keeper
+	assert.Empty(t, validators)
 	assert.Equal(t, uint32(2), params.QuorumNumerator)
 	assert.Equal(t, uint32(3), params.QuorumDenominator)
 }
 
 func TestKeeper_ParamsValidation(t *testing.T) {
 	// Test valid params
-	validParams := types.Params{
-		QuorumNumerator:   2,
-		QuorumDenominator: 3,
-	}
+	validParams := types.DefaultParams()
 	assert.NoError(t, validParams.Validate())
 
+	// Test invalid params - no storage URI length limit
+	invalidParams0 := validParams
+	invalidParams0.MaxStorageUriLength = 0
+	assert.Error(t, invalidParams0.Validate())
+
+	// Epochs, snapshot retention and the rotation delay are bounded
+	for name, mutate := range map[string]func(p *types.Params){
+		"zero epoch length":          func(p *types.Params) { p.EpochLength = 0 },
+		"snapshot retention of one":  func(p *types.Params) { p.SnapshotRetention = 1 },
+		"zero key rotation delay":    func(p *types.Params) { p.KeyRotationDelay = 0 },
+		"key rotation delay too big": func(p *types.Params) { p.KeyRotationDelay = types.MaxKeyRotationDelay + 1 },
+		"grace period outlives the duty set": func(p *types.Params) {
+			p.SnapshotRetention = 2
+			p.CheckpointSigningGracePeriod = p.EpochLength
+		},
+	} {
+		invalid := validParams
+		mutate(&invalid)
+		assert.Error(t, invalid.Validate(), name)
+	}
+	bounded := validParams
+	bounded.SnapshotRetention = 2
+	bounded.CheckpointSigningGracePeriod = bounded.EpochLength - 1
+	bounded.KeyRotationDelay = types.MaxKeyRotationDelay
+	assert.NoError(t, bounded.Validate())
+
 	// Test invalid params - zero numerator
 	invalidParams1 := types.Params{
 		QuorumNumerator:   0,
@@ -145,3 +303,1369 @@ func TestKeeper_ParamsValidation(t *testing.T) {
 	}
 	assert.Error(t, invalidParams3.Validate())
 }
+
+func TestKeeper_DutySetSnapshots(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	// No snapshot yet
+	_, found, err := keeper.GetDutySetAtHeight(ctx, 10)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// Store snapshots for epochs 1..3 at heights 10, 20, 30
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, keeper.SetDutySetSnapshot(ctx, types.DutySetSnapshot{
+			Epoch:  uint64(i),
+			Height: i * 10,
+			Validators: []*types.DutyValidator{
+				{ValConsAddr: "cosmosvalcons1test", VotingPower: "100"},
+			},
+			QuorumNum: 2,
+			QuorumDen: 3,
+		}))
+	}
+
+	// Height lookups resolve to the snapshot in effect
+	_, found, _ = keeper.GetDutySetAtHeight(ctx, 9)
+	assert.False(t, found)
+	snapshot, found, err := keeper.GetDutySetAtHeight(ctx, 25)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, uint64(2), snapshot.Epoch)
+	snapshot, found, _ = keeper.GetDutySetAtHeight(ctx, 30)
+	assert.True(t, found)
+	assert.Equal(t, uint64(3), snapshot.Epoch)
+
+	// Epoch lookups
+	snapshot, found, err = keeper.GetDutySetByEpoch(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int64(10), snapshot.Height)
+
+	latest, found, err := keeper.GetLatestDutySetSnapshot(ctx)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, uint64(3), latest.Epoch)
+
+	// Pruning removes old epochs from both indexes
+	require.NoError(t, keeper.pruneDutySetSnapshots(ctx, 2))
+	_, found, _ = keeper.GetDutySetByEpoch(ctx, 2)
+	assert.False(t, found)
+	_, found, _ = keeper.GetDutySetAtHeight(ctx, 25)
+	assert.False(t, found)
+	_, found, _ = keeper.GetDutySetByEpoch(ctx, 3)
+	assert.True(t, found)
+}
+
+func TestKeeper_NextEpochHeight(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	next := func(height int64) int64 {
+		h, err := keeper.NextEpochHeight(ctx.WithBlockHeight(height))
+		require.NoError(t, err)
+		return h
+	}
+	assert.Equal(t, int64(20), next(15))
+	assert.Equal(t, int64(30), next(20))
+}
+
+func TestKeeper_CheckpointMissedBitmap(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	consAddr := sdk.ConsAddress([]byte("test-validator"))
+
+	missed := func(addr sdk.ConsAddress, index int64) bool {
+		m, err := keeper.getCheckpointMissed(ctx, addr, index)
+		require.NoError(t, err)
+		return m
+	}
+
+	assert.False(t, missed(consAddr, 0))
+
+	require.NoError(t, keeper.setCheckpointMissed(ctx, consAddr, 0, true))
+	require.NoError(t, keeper.setCheckpointMissed(ctx, consAddr, 5, true))
+	assert.True(t, missed(consAddr, 0))
+	assert.True(t, missed(consAddr, 5))
+
+	require.NoError(t, keeper.setCheckpointMissed(ctx, consAddr, 0, false))
+	assert.False(t, missed(consAddr, 0))
+
+	// Clearing only affects this validator
+	other := sdk.ConsAddress([]byte("other-validator"))
+	require.NoError(t, keeper.setCheckpointMissed(ctx, other, 5, true))
+	require.NoError(t, keeper.clearCheckpointMissed(ctx, consAddr))
+	assert.False(t, missed(consAddr, 5))
+	assert.True(t, missed(other, 5))
+}
+
+func TestKeeper_CheckpointKeyIndex(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	compressed := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	uncompressed := "0x" + hex.EncodeToString(priv.PubKey().SerializeUncompressed())
+	addr, err := types.CheckpointAddress(compressed)
+	require.NoError(t, err)
+
+	valA := sdk.ConsAddress([]byte("validator-a"))
+	valB := sdk.ConsAddress([]byte("validator-b"))
+
+	require.NoError(t, keeper.SetDutyMetadata(ctx, valA, types.DutyMetadata{CheckpointPubKey: compressed}))
+	owner, found, err := keeper.GetConsAddrByCheckpointAddress(ctx, addr)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, valA, owner)
+
+	// Another validator cannot claim the same key, in any encoding
+	assert.ErrorIs(t, keeper.SetDutyMetadata(ctx, valB, types.DutyMetadata{CheckpointPubKey: uncompressed}), types.ErrCheckpointKeyInUse)
+
+	// Re-setting the same key for the owner is fine
+	require.NoError(t, keeper.SetDutyMetadata(ctx, valA, types.DutyMetadata{CheckpointPubKey: uncompressed}))
+
+	// Moving to a new key releases the old one
+	other, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetDutyMetadata(ctx, valA, types.DutyMetadata{CheckpointPubKey: "0x" + hex.EncodeToString(other.PubKey().SerializeCompressed())}))
+	_, found, _ = keeper.GetConsAddrByCheckpointAddress(ctx, addr)
+	assert.False(t, found)
+	require.NoError(t, keeper.SetDutyMetadata(ctx, valB, types.DutyMetadata{CheckpointPubKey: compressed}))
+}
+
+func TestKeeper_OriginDomains(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	msgServer := NewMsgServerImpl(keeper)
+
+	domain := types.OriginDomain{
+		DomainId:       1,
+		Name:           "ethereum",
+		Mailbox:        "0x" + strings.Repeat("AB", 32),
+		MerkleTreeHook: "0x" + strings.Repeat("cd", 32),
+		Quorum:         &types.QuorumOverride{QuorumNum: 1, QuorumDen: 2},
+	}
+
+	// Only the authority may register domains
+	other := sdk.AccAddress([]byte("not-the-authority---")).String()
+	_, err := msgServer.RegisterOriginDomain(ctx, &types.MsgRegisterOriginDomain{Authority: other, Domain: domain})
+	assert.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = msgServer.RegisterOriginDomain(ctx, &types.MsgRegisterOriginDomain{Authority: keeper.GetAuthority(), Domain: domain})
+	require.NoError(t, err)
+	stored, found, err := keeper.GetOriginDomain(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "0x"+strings.Repeat("ab", 32), stored.Mailbox)
+
+	// The override applies to duty sets resolved for the domain only
+	dutySet := types.DutySetSnapshot{QuorumNum: 2, QuorumDen: 3}
+	resolved, err := keeper.dutySetForDomain(ctx, dutySet, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), resolved.QuorumNum)
+	resolved, err = keeper.dutySetForDomain(ctx, dutySet, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), resolved.QuorumNum)
+
+	_, err = msgServer.RemoveOriginDomain(ctx, &types.MsgRemoveOriginDomain{Authority: keeper.GetAuthority(), DomainId: 1})
+	require.NoError(t, err)
+	_, found, err = keeper.GetOriginDomain(ctx, 1)
+	require.NoError(t, err)
+	assert.False(t, found)
+	_, err = msgServer.RemoveOriginDomain(ctx, &types.MsgRemoveOriginDomain{Authority: keeper.GetAuthority(), DomainId: 1})
+	assert.ErrorIs(t, err, types.ErrInvalidOriginDomain)
+}
+
+func TestKeeper_DomainCheckpointKeyIndex(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	newKey := func() string {
+		priv, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		return "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	}
+	keyA, keyB, domainKey := newKey(), newKey(), newKey()
+	domainAddr, err := types.CheckpointAddress(domainKey)
+	require.NoError(t, err)
+
+	valA := sdk.ConsAddress([]byte("validator-a"))
+	valB := sdk.ConsAddress([]byte("validator-b"))
+
+	require.NoError(t, keeper.SetDutyMetadata(ctx, valA, types.DutyMetadata{
+		CheckpointPubKey: keyA,
+		DomainConfigs:    []*types.DomainCheckpointConfig{{OriginDomain: 1, CheckpointPubKey: domainKey}},
+	}))
+	owner, found, err := keeper.GetConsAddrByCheckpointAddress(ctx, domainAddr)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, valA, owner)
+
+	// A per-domain key is as exclusive as a default key
+	assert.ErrorIs(t, keeper.SetDutyMetadata(ctx, valB, types.DutyMetadata{CheckpointPubKey: domainKey}), types.ErrCheckpointKeyInUse)
+	assert.ErrorIs(t, keeper.SetDutyMetadata(ctx, valB, types.DutyMetadata{
+		CheckpointPubKey: keyB,
+		DomainConfigs:    []*types.DomainCheckpointConfig{{OriginDomain: 2, CheckpointPubKey: domainKey}},
+	}), types.ErrCheckpointKeyInUse)
+
+	// Dropping the override releases the key
+	require.NoError(t, keeper.SetDutyMetadata(ctx, valA, types.DutyMetadata{CheckpointPubKey: keyA}))
+	_, found, _ = keeper.GetConsAddrByCheckpointAddress(ctx, domainAddr)
+	assert.False(t, found)
+	require.NoError(t, keeper.SetDutyMetadata(ctx, valB, types.DutyMetadata{CheckpointPubKey: domainKey}))
+}
+
+func TestKeeper_DutyNonce(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	queryServer := NewQueryServer(keeper)
+	valA := sdk.ConsAddress([]byte("validator-a"))
+
+	// Only the current nonce is accepted, and each use advances it
+	assert.ErrorIs(t, keeper.useDutyNonce(ctx, valA, 1), types.ErrInvalidNonce)
+	require.NoError(t, keeper.useDutyNonce(ctx, valA, 0))
+	assert.ErrorIs(t, keeper.useDutyNonce(ctx, valA, 0), types.ErrInvalidNonce)
+	require.NoError(t, keeper.useDutyNonce(ctx, valA, 1))
+
+	res, err := queryServer.DutyNonce(ctx, &types.QueryDutyNonceRequest{ConsAddr: valA.String()})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), res.Nonce)
+
+	// Nonces are per validator
+	res, err = queryServer.DutyNonce(ctx, &types.QueryDutyNonceRequest{ConsAddr: sdk.ConsAddress([]byte("validator-b")).String()})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), res.Nonce)
+}
+
+func TestKeeper_ArchiveDutyMetadata(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	queryServer := NewQueryServer(keeper)
+	ctx = ctx.WithBlockHeight(42)
+
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	key := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	addr, err := types.CheckpointAddress(key)
+	require.NoError(t, err)
+
+	valA := sdk.ConsAddress([]byte("validator-a"))
+	valB := sdk.ConsAddress([]byte("validator-b"))
+	require.NoError(t, keeper.SetDutyMetadata(ctx, valA, types.DutyMetadata{CheckpointPubKey: key}))
+	require.NoError(t, keeper.useDutyNonce(ctx, valA, 0))
+
+	tombstone, archived, err := keeper.ArchiveDutyMetadata(ctx, valA, sdk.ValAddress([]byte("operator-a")))
+	require.NoError(t, err)
+	require.True(t, archived)
+	assert.Equal(t, int64(42), tombstone.RemovalHeight)
+
+	// The metadata is gone and the key is free, but the nonce is kept
+	_, found, err := keeper.GetDutyMetadata(ctx, valA)
+	require.NoError(t, err)
+	assert.False(t, found)
+	_, found, err = keeper.GetConsAddrByCheckpointAddress(ctx, addr)
+	require.NoError(t, err)
+	assert.False(t, found)
+	nonce, err := keeper.GetDutyNonce(ctx, valA)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce)
+	require.NoError(t, keeper.SetDutyMetadata(ctx, valB, types.DutyMetadata{CheckpointPubKey: key}))
+
+	res, err := queryServer.ArchivedDutyMetadata(ctx, &types.QueryArchivedDutyMetadataRequest{ConsAddr: valA.String()})
+	require.NoError(t, err)
+	require.NotNil(t, res.Tombstone)
+	assert.Equal(t, key, res.Tombstone.Metadata.CheckpointPubKey)
+
+	byKey, err := queryServer.ArchivedDutyMetadataByCheckpointKey(ctx, &types.QueryArchivedDutyMetadataByCheckpointKeyRequest{CheckpointKey: key})
+	require.NoError(t, err)
+	require.Len(t, byKey.Tombstones, 1)
+	assert.Equal(t, valA.String(), byKey.Tombstones[0].ValConsAddr)
+
+	// Nothing to archive for a validator without metadata
+	_, archived, err = keeper.ArchiveDutyMetadata(ctx, sdk.ConsAddress([]byte("validator-c")), nil)
+	require.NoError(t, err)
+	assert.False(t, archived)
+}
+
+func TestKeeper_PendingKeyRotation(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	queryServer := NewQueryServer(keeper)
+
+	privA, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	privB, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	keyA := "0x" + hex.EncodeToString(privA.PubKey().SerializeCompressed())
+	keyB := "0x" + hex.EncodeToString(privB.PubKey().SerializeCompressed())
+	sign := func(priv *secp256k1.PrivateKey, digest []byte) string {
+		compact := ecdsa.SignCompact(priv, types.EthSignedMessageHash(digest), false)
+		return "0x" + hex.EncodeToString(append(compact[1:], compact[0]))
+	}
+	digest := types.Keccak256([]byte("checkpoint"))
+
+	valA := sdk.ConsAddress([]byte("validator-a"))
+	meta := types.DutyMetadata{}
+	meta.SetCheckpointKey(keyA, 1)
+	meta.ScheduleKeyRotation(keyB, 10, 15)
+	require.NoError(t, keeper.SetDutyMetadata(ctx, valA, meta))
+
+	// The pending key is reserved and signs alongside the current key
+	addrB, err := types.CheckpointAddress(keyB)
+	require.NoError(t, err)
+	owner, found, err := keeper.GetConsAddrByCheckpointAddress(ctx, addrB)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, valA, owner)
+
+	ctx = ctx.WithBlockHeight(14)
+	require.NoError(t, keeper.ActivatePendingCheckpointKeys(ctx))
+	assert.NoError(t, keeper.verifyCheckpointSigner(ctx, valA, 1, keyA, digest, sign(privA, digest)))
+	assert.NoError(t, keeper.verifyCheckpointSigner(ctx, valA, 1, keyA, digest, sign(privB, digest)))
+
+	res, err := queryServer.CheckpointKeys(ctx, &types.QueryCheckpointKeysRequest{ConsAddr: valA.String()})
+	require.NoError(t, err)
+	assert.Equal(t, keyA, res.Current.CheckpointPubKey)
+	assert.Equal(t, int64(15), res.Pending.ActivationHeight)
+	assert.Nil(t, res.Previous)
+
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, keeper.ActivatePendingCheckpointKeys(ctx))
+	res, err = queryServer.CheckpointKeys(ctx, &types.QueryCheckpointKeysRequest{ConsAddr: valA.String()})
+	require.NoError(t, err)
+	assert.Equal(t, &types.CheckpointKeyRecord{CheckpointPubKey: keyB, ValidFromHeight: 10}, res.Current)
+	assert.Nil(t, res.Pending)
+	assert.Equal(t, &types.CheckpointKeyRecord{CheckpointPubKey: keyA, ValidFromHeight: 1, ValidUntilHeight: 15}, res.Previous)
+	has, err := keeper.PendingKeyRotations.Has(ctx, collections.Join(int64(15), valA))
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	// Once retired, the old key no longer signs even where the duty set
+	// still records it
+	ctx = ctx.WithBlockHeight(16)
+	assert.ErrorIs(t, keeper.verifyCheckpointSigner(ctx, valA, 1, keyA, digest, sign(privA, digest)), types.ErrInvalidAttestation)
+	assert.NoError(t, keeper.verifyCheckpointSigner(ctx, valA, 1, keyA, digest, sign(privB, digest)))
+}
+
+func TestKeeper_MigrateConsensusAddress(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	queryServer := NewQueryServer(keeper)
+	ctx = ctx.WithBlockHeight(30)
+
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	key := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	addr, err := types.CheckpointAddress(key)
+	require.NoError(t, err)
+
+	oldAddr := sdk.ConsAddress([]byte("validator-old"))
+	newAddr := sdk.ConsAddress([]byte("validator-new"))
+	require.NoError(t, keeper.SetDutyMetadata(ctx, oldAddr, types.DutyMetadata{CheckpointPubKey: key}))
+	require.NoError(t, keeper.useDutyNonce(ctx, oldAddr, 0))
+	require.NoError(t, keeper.SetCheckpointSigningInfo(ctx, oldAddr, types.CheckpointSigningInfo{ValConsAddr: oldAddr.String(), MissedCheckpointsCounter: 1}))
+	require.NoError(t, keeper.setCheckpointMissed(ctx, oldAddr, 3, true))
+
+	require.NoError(t, keeper.MigrateConsensusAddress(ctx, oldAddr, newAddr))
+
+	// Metadata, key index, nonce and liveness follow the validator
+	_, found, err := keeper.GetDutyMetadata(ctx, oldAddr)
+	require.NoError(t, err)
+	assert.False(t, found)
+	meta, found, err := keeper.GetDutyMetadata(ctx, newAddr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, key, meta.CheckpointPubKey)
+	owner, found, err := keeper.GetConsAddrByCheckpointAddress(ctx, addr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, newAddr, owner)
+	nonce, err := keeper.GetDutyNonce(ctx, newAddr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce)
+	info, found, err := keeper.GetCheckpointSigningInfo(ctx, newAddr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, newAddr.String(), info.ValConsAddr)
+	missed, err := keeper.getCheckpointMissed(ctx, newAddr, 3)
+	require.NoError(t, err)
+	assert.True(t, missed)
+	_, found, err = keeper.GetCheckpointSigningInfo(ctx, oldAddr)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	res, err := queryServer.ConsensusKeyMigration(ctx, &types.QueryConsensusKeyMigrationRequest{ConsAddr: oldAddr.String()})
+	require.NoError(t, err)
+	require.NotNil(t, res.MigratedTo)
+	assert.Equal(t, newAddr.String(), res.MigratedTo.NewConsAddr)
+	assert.Equal(t, int64(30), res.MigratedTo.Height)
+	assert.Equal(t, newAddr.String(), res.CurrentConsAddr)
+	res, err = queryServer.ConsensusKeyMigration(ctx, &types.QueryConsensusKeyMigrationRequest{ConsAddr: newAddr.String()})
+	require.NoError(t, err)
+	require.NotNil(t, res.MigratedFrom)
+	assert.Equal(t, oldAddr.String(), res.MigratedFrom.OldConsAddr)
+
+	// A duty set committed before the rotation still finds the validator
+	dutySet := types.DutySetSnapshot{Validators: []*types.DutyValidator{{ValConsAddr: oldAddr.String(), CheckpointPubKey: key}}}
+	member, found, err := keeper.findDutySetMember(ctx, dutySet, newAddr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, oldAddr.String(), member.ValConsAddr)
+
+	// Migrating onto an address that already has metadata fails
+	require.NoError(t, keeper.SetDutyMetadata(ctx, oldAddr, types.DutyMetadata{}))
+	assert.Error(t, keeper.MigrateConsensusAddress(ctx, oldAddr, newAddr))
+}
+
+func TestKeeper_MigrateRotatedConsensusKeys(t *testing.T) {
+	validator, consPriv, valAddr := newTestValidator(t)
+	removed, removedConsPriv, removedValAddr := newTestValidator(t)
+	stakingKeeper := newMockStakingKeeper(validator, removed)
+	keeper, ctx := setupTestKeeperWithStaking(t, stakingKeeper)
+
+	oldAddr := sdk.ConsAddress(consPriv.PubKey().Address())
+	require.NoError(t, keeper.SetDutyMetadata(ctx, oldAddr, types.DutyMetadata{CheckpointStorageUri: "s3://bucket/a/"}))
+	require.NoError(t, keeper.SetValidatorConsAddr(ctx, valAddr, oldAddr))
+	require.NoError(t, keeper.SetValidatorConsAddr(ctx, removedValAddr, sdk.ConsAddress(removedConsPriv.PubKey().Address())))
+
+	// Nothing moves while the consensus key is unchanged
+	require.NoError(t, keeper.EndBlocker(ctx))
+	_, found, err := keeper.GetDutyMetadata(ctx, oldAddr)
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	// The staking module rotates the consensus key and drops the other validator
+	newConsPriv := ed25519.GenPrivKey()
+	rotated, err := stakingtypes.NewValidator(valAddr.String(), newConsPriv.PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	stakingKeeper.validators = []stakingtypes.Validator{rotated}
+	newAddr := sdk.ConsAddress(newConsPriv.PubKey().Address())
+
+	require.NoError(t, keeper.MigrateRotatedConsensusKeys(ctx))
+	meta, found, err := keeper.GetDutyMetadata(ctx, newAddr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "s3://bucket/a/", meta.CheckpointStorageUri)
+	migration, found, err := keeper.GetConsensusKeyMigration(ctx, oldAddr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, newAddr.String(), migration.NewConsAddr)
+	tracked, err := keeper.ValidatorConsAddrs.Get(ctx, valAddr)
+	require.NoError(t, err)
+	assert.Equal(t, newAddr, tracked)
+	has, err := keeper.ValidatorConsAddrs.Has(ctx, removedValAddr)
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
+func TestQueryServer_AnnouncedStorageLocations(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	queryServer := NewQueryServer(keeper)
+
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	pubKey := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	addr, err := types.CheckpointAddress(pubKey)
+	require.NoError(t, err)
+	validator := "0x" + hex.EncodeToString(addr)
+
+	announce := func(location string) bool {
+		added, err := keeper.AddStorageAnnouncement(ctx, types.StorageAnnouncement{OriginDomain: 1, Validator: validator, StorageLocation: location})
+		require.NoError(t, err)
+		return added
+	}
+	assert.True(t, announce("s3://bucket/a"))
+
+	unknown := "0x" + strings.Repeat("11", 20)
+	res, err := queryServer.AnnouncedStorageLocations(ctx, &types.QueryAnnouncedStorageLocationsRequest{
+		OriginDomain: 1,
+		Validators:   []string{validator, unknown},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.StorageLocations, 2)
+	assert.Equal(t, []string{"s3://bucket/a"}, res.StorageLocations[0].Locations)
+	assert.Empty(t, res.StorageLocations[1].Locations)
+
+	// Every location is kept in the order announced, each once
+	assert.True(t, announce("s3://bucket/b"))
+	assert.False(t, announce("s3://bucket/a"))
+	res, err = queryServer.AnnouncedStorageLocations(ctx, &types.QueryAnnouncedStorageLocationsRequest{OriginDomain: 1, Validators: []string{validator}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s3://bucket/a", "s3://bucket/b"}, res.StorageLocations[0].Locations)
+	res, err = queryServer.AnnouncedStorageLocations(ctx, &types.QueryAnnouncedStorageLocationsRequest{OriginDomain: 2, Validators: []string{validator}})
+	require.NoError(t, err)
+	assert.Empty(t, res.StorageLocations[0].Locations)
+
+	// Announcements need a registered domain for its mailbox
+	meta := types.DutyMetadata{CheckpointPubKey: pubKey, CheckpointStorageUri: "s3://bucket/a"}
+	_, err = keeper.verifyAnnouncement(ctx, meta, types.ValidatorAnnouncement{OriginDomain: 2, Signature: "0x00"})
+	assert.ErrorIs(t, err, types.ErrInvalidOriginDomain)
+
+	_, err = queryServer.AnnouncedStorageLocations(ctx, &types.QueryAnnouncedStorageLocationsRequest{OriginDomain: 1, Validators: []string{"0x1234"}})
+	assert.Error(t, err)
+}
+
+func TestMigrator_Migrate1to2(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	store := keeper.storeService.OpenKVStore(ctx)
+
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	key := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	consAddr := sdk.ConsAddress("test-cons-addr-1234")
+	require.NoError(t, store.Set(append(types.DutyMetaPrefix.Bytes(), consAddr...),
+		[]byte(`{"checkpoint_pub_key":"`+key+`","checkpoint_storage_uri":"s3://bucket/checkpoints"}`)))
+	// v1 did not enforce unique keys; the later validator is left unindexed
+	duplicate := sdk.ConsAddress("test-cons-addr-5678")
+	require.NoError(t, store.Set(append(types.DutyMetaPrefix.Bytes(), duplicate...),
+		[]byte(`{"checkpoint_pub_key":"`+key+`","checkpoint_storage_uri":"s3://bucket/other"}`)))
+
+	require.NoError(t, NewMigrator(keeper, nil).Migrate1to2(ctx))
+
+	meta, found, err := keeper.GetDutyMetadata(ctx, consAddr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, key, meta.CheckpointPubKey)
+	assert.Equal(t, "s3://bucket/checkpoints", meta.CheckpointStorageUri)
+
+	// The checkpoint address index is built from the migrated metadata
+	addr, err := types.CheckpointAddress(key)
+	require.NoError(t, err)
+	owner, found, err := keeper.GetConsAddrByCheckpointAddress(ctx, addr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, consAddr, owner)
+
+	// A record that is not valid JSON aborts the migration
+	require.NoError(t, store.Set(append(types.DutyMetaPrefix.Bytes(), "corrupt"...), []byte{0x0a, 0x01}))
+	require.Error(t, NewMigrator(keeper, nil).Migrate1to2(ctx))
+}
+
+// fakeSubspace stands in for the legacy x/params subspace.
+type fakeSubspace struct{ quorumNum, quorumDen uint32 }
+
+func (s fakeSubspace) GetParamSetIfExists(_ sdk.Context, ps paramtypes.ParamSet) {
+	p := ps.(*types.Params)
+	p.QuorumNumerator, p.QuorumDenominator = s.quorumNum, s.quorumDen
+}
+
+func TestMigrator_Migrate2to3(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	store := keeper.storeService.OpenKVStore(ctx)
+	require.NoError(t, keeper.Params.Remove(ctx))
+
+	// The subspace holds 3/4; the params service later wrote a denominator
+	// and epoch length as bare JSON keys
+	require.NoError(t, store.Set([]byte("QuorumDenominator"), []byte(`5`)))
+	require.NoError(t, store.Set([]byte("EpochLength"), []byte(`50`)))
+
+	require.NoError(t, NewMigrator(keeper, fakeSubspace{3, 4}).Migrate2to3(ctx))
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), params.QuorumNumerator)
+	assert.Equal(t, uint32(5), params.QuorumDenominator)
+	assert.Equal(t, uint64(50), params.EpochLength)
+	assert.Equal(t, types.DefaultSignedCheckpointsWindow, params.SignedCheckpointsWindow)
+	has, err := store.Has([]byte("EpochLength"))
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	// An existing params item wins; stray legacy keys are still removed
+	require.NoError(t, store.Set([]byte("QuorumNumerator"), []byte(`1`)))
+	require.NoError(t, NewMigrator(keeper, fakeSubspace{1, 2}).Migrate2to3(ctx))
+	params, err = keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), params.QuorumNumerator)
+	has, err = store.Has([]byte("QuorumNumerator"))
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	// Legacy values that do not validate fail the upgrade
+	require.NoError(t, keeper.Params.Remove(ctx))
+	require.Error(t, NewMigrator(keeper, fakeSubspace{4, 3}).Migrate2to3(ctx))
+}
+
+func TestMigrator_Migrate3to4(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	// Params written before v4 have none of the params added since
+	params := types.DefaultParams()
+	params.MaxStorageUriLength = 0
+	params.KeyRotationDelay = 0
+	params.CheckpointSigningGracePeriod = 0
+	require.NoError(t, keeper.Params.Set(ctx, params))
+
+	require.NoError(t, NewMigrator(keeper, nil).Migrate3to4(ctx))
+	stored, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultMaxStorageURILength, stored.MaxStorageUriLength)
+
+	// A limit that is already set is kept
+	params.MaxStorageUriLength = 100
+	require.NoError(t, keeper.Params.Set(ctx, params))
+	require.NoError(t, NewMigrator(keeper, nil).Migrate3to4(ctx))
+	stored, err = keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(100), stored.MaxStorageUriLength)
+}
+
+func TestMigrator_Migrate4to5(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	// Params written before v5 decode the rotation delay, and the params
+	// added since, as zero
+	params := types.DefaultParams()
+	params.KeyRotationDelay = 0
+	params.CheckpointSigningGracePeriod = 0
+	require.NoError(t, keeper.Params.Set(ctx, params))
+
+	require.NoError(t, NewMigrator(keeper, nil).Migrate4to5(ctx))
+	stored, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultKeyRotationDelay, stored.KeyRotationDelay)
+
+	// A delay that is already set is kept
+	params.KeyRotationDelay = 500
+	require.NoError(t, keeper.Params.Set(ctx, params))
+	require.NoError(t, NewMigrator(keeper, nil).Migrate4to5(ctx))
+	stored, err = keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(500), stored.KeyRotationDelay)
+}
+
+func TestMigrator_Migrate5to6(t *testing.T) {
+	validator, consPriv, valAddr := newTestValidator(t)
+	keeper, ctx := setupTestKeeperWithStaking(t, newMockStakingKeeper(validator))
+
+	// Metadata of a validator unknown to staking is left untracked
+	consAddr := sdk.ConsAddress(consPriv.PubKey().Address())
+	validatorAddr := "0x" + strings.Repeat("22", 20)
+	require.NoError(t, keeper.SetDutyMetadata(ctx, consAddr, types.DutyMetadata{
+		Announcements: []*types.StorageAnnouncement{{OriginDomain: 1, Validator: validatorAddr, StorageLocation: "s3://bucket/a"}},
+	}))
+	require.NoError(t, keeper.SetDutyMetadata(ctx, sdk.ConsAddress([]byte("validator-gone")), types.DutyMetadata{}))
+
+	require.NoError(t, NewMigrator(keeper, nil).Migrate5to6(ctx))
+	tracked, err := keeper.ValidatorConsAddrs.Get(ctx, valAddr)
+	require.NoError(t, err)
+	assert.Equal(t, consAddr, tracked)
+	iter, err := keeper.ValidatorConsAddrs.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+
+	// Announcements move out of the metadata
+	meta, _, err := keeper.GetDutyMetadata(ctx, consAddr)
+	require.NoError(t, err)
+	assert.Empty(t, meta.Announcements)
+	addr, err := types.DecodeHex(validatorAddr)
+	require.NoError(t, err)
+	locations, err := keeper.GetAnnouncedStorageLocations(ctx, 1, addr)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s3://bucket/a"}, locations)
+}
+
+func TestMigrator_Migrate6to7(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+
+	// Params written before v7 decode the grace period as zero
+	params := types.DefaultParams()
+	params.CheckpointSigningGracePeriod = 0
+	require.NoError(t, keeper.Params.Set(ctx, params))
+
+	require.NoError(t, NewMigrator(keeper, nil).Migrate6to7(ctx))
+	stored, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultCheckpointSigningGracePeriod, stored.CheckpointSigningGracePeriod)
+	require.NoError(t, stored.Validate())
+
+	// The default is lowered to end before a retained duty set is pruned
+	params.SnapshotRetention = 2
+	params.EpochLength = 10
+	require.NoError(t, keeper.Params.Set(ctx, params))
+	require.NoError(t, NewMigrator(keeper, nil).Migrate6to7(ctx))
+	stored, err = keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), stored.CheckpointSigningGracePeriod)
+	require.NoError(t, stored.Validate())
+
+	// A grace period that is already set is kept
+	params.CheckpointSigningGracePeriod = 5
+	require.NoError(t, keeper.Params.Set(ctx, params))
+	require.NoError(t, NewMigrator(keeper, nil).Migrate6to7(ctx))
+	stored, err = keeper.GetParams(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), stored.CheckpointSigningGracePeriod)
+}
+
+func TestQueryServer_DutyValidator(t *testing.T) {
+	validator, consPriv, valAddr := newTestValidator(t)
+	keeper, ctx := setupTestKeeperWithStaking(t, newMockStakingKeeper(validator))
+	queryServer := NewQueryServer(keeper)
+
+	consAddr := sdk.ConsAddress(consPriv.PubKey().Address())
+	meta := types.DutyMetadata{CheckpointStorageUri: "s3://bucket/a/"}
+	require.NoError(t, keeper.SetDutyMetadata(ctx, consAddr, meta))
+
+	// The operator and consensus address forms find the same validator
+	for _, address := range []string{valAddr.String(), consAddr.String()} {
+		res, err := queryServer.DutyValidator(ctx, &types.QueryDutyValidatorRequest{Address: address})
+		require.NoError(t, err, address)
+		assert.Equal(t, valAddr.String(), res.ValAddr)
+		assert.Equal(t, consAddr.String(), res.ConsAddr)
+		assert.Equal(t, "bonded", res.Status)
+		assert.Equal(t, "10", res.VotingPower)
+		require.NotNil(t, res.Metadata)
+		assert.Equal(t, meta.CheckpointStorageUri, res.Metadata.CheckpointStorageUri)
+	}
+
+	// An address the validator rotated away from still finds it
+	oldAddr := sdk.ConsAddress([]byte("validator-old"))
+	require.NoError(t, keeper.SetConsensusKeyMigration(ctx, types.ConsensusKeyMigration{OldConsAddr: oldAddr.String(), NewConsAddr: consAddr.String()}))
+	res, err := queryServer.DutyValidator(ctx, &types.QueryDutyValidatorRequest{Address: oldAddr.String()})
+	require.NoError(t, err)
+	assert.Equal(t, valAddr.String(), res.ValAddr)
+
+	// Unknown validators are not found in either form
+	unknownConsAddr := sdk.ConsAddress([]byte("validator-unknown"))
+	for _, address := range []string{sdk.ValAddress(unknownConsAddr).String(), unknownConsAddr.String()} {
+		_, err := queryServer.DutyValidator(ctx, &types.QueryDutyValidatorRequest{Address: address})
+		assert.ErrorIs(t, err, sdkerrors.ErrNotFound, address)
+	}
+	_, err = queryServer.DutyValidator(ctx, &types.QueryDutyValidatorRequest{Address: "not-an-address"})
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
+
+func TestQueryServer_DutyMetadataByOperator(t *testing.T) {
+	validator, consPriv, valAddr := newTestValidator(t)
+	otherValidator, _, otherValAddr := newTestValidator(t)
+	keeper, ctx := setupTestKeeperWithStaking(t, newMockStakingKeeper(validator, otherValidator))
+	queryServer := NewQueryServer(keeper)
+
+	consAddr := sdk.ConsAddress(consPriv.PubKey().Address())
+	require.NoError(t, keeper.SetDutyMetadata(ctx, consAddr, types.DutyMetadata{CheckpointStorageUri: "s3://bucket/a/"}))
+
+	res, err := queryServer.DutyMetadataByOperator(ctx, &types.QueryDutyMetadataByOperatorRequest{ValAddr: valAddr.String()})
+	require.NoError(t, err)
+	assert.Equal(t, consAddr.String(), res.ConsAddr)
+	require.NotNil(t, res.Metadata)
+	assert.Equal(t, "s3://bucket/a/", res.Metadata.CheckpointStorageUri)
+
+	// A validator without metadata resolves its consensus address only
+	res, err = queryServer.DutyMetadataByOperator(ctx, &types.QueryDutyMetadataByOperatorRequest{ValAddr: otherValAddr.String()})
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.ConsAddr)
+	assert.Nil(t, res.Metadata)
+
+	_, err = queryServer.DutyMetadataByOperator(ctx, &types.QueryDutyMetadataByOperatorRequest{ValAddr: sdk.ValAddress([]byte("validator-unknown")).String()})
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	_, err = queryServer.DutyMetadataByOperator(ctx, &types.QueryDutyMetadataByOperatorRequest{ValAddr: consAddr.String()})
+	assert.Error(t, err)
+}
+
+func TestValidatorDutyStatus(t *testing.T) {
+	tests := []struct {
+		status stakingtypes.BondStatus
+		jailed bool
+		want   string
+	}{
+		{stakingtypes.Bonded, false, "bonded"},
+		{stakingtypes.Unbonding, false, "unbonding"},
+		{stakingtypes.Unbonded, false, "unbonded"},
+		{stakingtypes.Unbonding, true, "jailed"},
+		{stakingtypes.Unbonded, true, "jailed"},
+	}
+	for _, tt := range tests {
+		v := stakingtypes.Validator{Status: tt.status, Jailed: tt.jailed}
+		assert.Equal(t, tt.want, validatorDutyStatus(v))
+	}
+}
+
+func TestFilterAndPaginateDutyValidators(t *testing.T) {
+	validators := []*types.DutyValidator{
+		{ValConsAddr: "cosmosvalcons1a", VotingPower: "300", CheckpointPubKey: "0x02aa"},
+		{ValConsAddr: "cosmosvalcons1b", VotingPower: "200"},
+		{ValConsAddr: "cosmosvalcons1c", VotingPower: "200", CheckpointPubKey: "0x02cc"},
+		{ValConsAddr: "cosmosvalcons1d", VotingPower: "100", CheckpointPubKey: "0x02dd"},
+	}
+	addrs := func(vs []*types.DutyValidator) []string {
+		out := make([]string, len(vs))
+		for i, v := range vs {
+			out[i] = v.ValConsAddr
+		}
+		return out
+	}
+
+	out, err := filterDutyValidators(validators, &types.QueryDutySetRequest{OnlyWithMetadata: true, MinVotingPower: "150"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cosmosvalcons1a", "cosmosvalcons1c"}, addrs(out))
+
+	out, err = filterDutyValidators(validators, &types.QueryDutySetRequest{MissingMetadata: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cosmosvalcons1b"}, addrs(out))
+
+	out, err = filterDutyValidators(validators, &types.QueryDutySetRequest{Order: types.DutySetOrder_DUTY_SET_ORDER_POWER_ASC})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cosmosvalcons1d", "cosmosvalcons1b", "cosmosvalcons1c", "cosmosvalcons1a"}, addrs(out))
+
+	_, err = filterDutyValidators(validators, &types.QueryDutySetRequest{OnlyWithMetadata: true, MissingMetadata: true})
+	assert.Error(t, err)
+	_, err = filterDutyValidators(validators, &types.QueryDutySetRequest{MinVotingPower: "lots"})
+	assert.Error(t, err)
+
+	// Key-based paging walks the whole set without overlap
+	page, res, err := paginateDutyValidators(validators, &query.PageRequest{Limit: 3, CountTotal: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cosmosvalcons1a", "cosmosvalcons1b", "cosmosvalcons1c"}, addrs(page))
+	assert.Equal(t, uint64(4), res.Total)
+	page, res, err = paginateDutyValidators(validators, &query.PageRequest{Limit: 3, Key: res.NextKey})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cosmosvalcons1d"}, addrs(page))
+	assert.Nil(t, res.NextKey)
+
+	page, _, err = paginateDutyValidators(validators, &query.PageRequest{Offset: 10})
+	require.NoError(t, err)
+	assert.Empty(t, page)
+
+	_, _, err = paginateDutyValidators(validators, &query.PageRequest{Key: []byte("cosmosvalcons1z")})
+	assert.Error(t, err)
+}
+
+// newTestValidator returns a bonded validator with a fresh ed25519 consensus
+// key, along with that key and its operator address.
+func newTestValidator(t *testing.T) (stakingtypes.Validator, cryptotypes.PrivKey, sdk.ValAddress) {
+	consPriv := ed25519.GenPrivKey()
+	valAddr := sdk.ValAddress(consPriv.PubKey().Address())
+	v, err := stakingtypes.NewValidator(valAddr.String(), consPriv.PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	v.Status = stakingtypes.Bonded
+	v.Tokens = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	return v, consPriv, valAddr
+}
+
+// signEthMessage returns the hex [R || S || V] EIP-191 signature of payload.
+func signEthMessage(priv *secp256k1.PrivateKey, payload []byte) string {
+	compact := ecdsa.SignCompact(priv, types.EthSignedMessageHash(payload), false)
+	return "0x" + hex.EncodeToString(append(compact[1:], compact[0]))
+}
+
+func TestMsgServer_BindCheckpointKey(t *testing.T) {
+	validator, consPriv, valAddr := newTestValidator(t)
+	otherValidator, otherConsPriv, otherValAddr := newTestValidator(t)
+	keeper, ctx := setupTestKeeperWithStaking(t, newMockStakingKeeper(validator, otherValidator))
+	ctx = ctx.WithChainID("duty-test").WithBlockHeight(5)
+	msgServer := NewMsgServerImpl(keeper)
+
+	consAddr := sdk.ConsAddress(consPriv.PubKey().Address())
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	key := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+
+	bindMsg := func(signer sdk.ValAddress, consKey cryptotypes.PrivKey, checkpointKey *secp256k1.PrivateKey, originDomain uint32, nonce uint64) *types.MsgBindCheckpointKey {
+		ca := sdk.ConsAddress(consKey.PubKey().Address())
+		pk := "0x" + hex.EncodeToString(checkpointKey.PubKey().SerializeCompressed())
+		consSig, err := consKey.Sign(types.BindConsensusKeyPayload(ctx.ChainID(), pk, originDomain, nonce))
+		require.NoError(t, err)
+		return &types.MsgBindCheckpointKey{
+			Signer:             signer.String(),
+			ConsensusAddress:   ca.String(),
+			CheckpointPubKey:   pk,
+			BindingSignature:   signEthMessage(checkpointKey, types.BindCheckpointKeyPayload(ctx.ChainID(), ca, originDomain, nonce)),
+			ConsensusSignature: "0x" + hex.EncodeToString(consSig),
+			Nonce:              nonce,
+			OriginDomain:       originDomain,
+		}
+	}
+
+	t.Run("wrong consensus signature", func(t *testing.T) {
+		msg := bindMsg(valAddr, consPriv, priv, 0, 0)
+		wrongSig, err := otherConsPriv.Sign(types.BindConsensusKeyPayload(ctx.ChainID(), key, 0, 0))
+		require.NoError(t, err)
+		msg.ConsensusSignature = "0x" + hex.EncodeToString(wrongSig)
+		cacheCtx, _ := ctx.CacheContext()
+		_, err = msgServer.BindCheckpointKey(cacheCtx, msg)
+		assert.ErrorIs(t, err, types.ErrInvalidBinding)
+	})
+
+	t.Run("wrong checkpoint signature", func(t *testing.T) {
+		wrongKey, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		msg := bindMsg(valAddr, consPriv, priv, 0, 0)
+		msg.BindingSignature = signEthMessage(wrongKey, types.BindCheckpointKeyPayload(ctx.ChainID(), consAddr, 0, 0))
+		cacheCtx, _ := ctx.CacheContext()
+		_, err = msgServer.BindCheckpointKey(cacheCtx, msg)
+		assert.ErrorIs(t, err, types.ErrInvalidBinding)
+	})
+
+	t.Run("stale nonce", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.BindCheckpointKey(cacheCtx, bindMsg(valAddr, consPriv, priv, 0, 0))
+		require.NoError(t, err)
+		// Replaying the same binding fails on the advanced nonce
+		_, err = msgServer.BindCheckpointKey(cacheCtx, bindMsg(valAddr, consPriv, priv, 0, 0))
+		assert.ErrorIs(t, err, types.ErrInvalidNonce)
+	})
+
+	t.Run("key already bound", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.BindCheckpointKey(cacheCtx, bindMsg(valAddr, consPriv, priv, 0, 0))
+		require.NoError(t, err)
+		_, err = msgServer.BindCheckpointKey(cacheCtx, bindMsg(otherValAddr, otherConsPriv, priv, 0, 0))
+		assert.ErrorIs(t, err, types.ErrCheckpointKeyInUse)
+	})
+
+	_, err = msgServer.BindCheckpointKey(ctx, bindMsg(valAddr, consPriv, priv, 0, 0))
+	require.NoError(t, err)
+	meta, found, err := keeper.GetDutyMetadata(ctx, consAddr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, key, meta.CheckpointPubKey)
+	nonce, err := keeper.GetDutyNonce(ctx, consAddr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce)
+
+	// A per-domain key is bound for a registered domain only
+	domainPriv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	domainKey := "0x" + hex.EncodeToString(domainPriv.PubKey().SerializeCompressed())
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.BindCheckpointKey(cacheCtx, bindMsg(valAddr, consPriv, domainPriv, 1, 1))
+	assert.ErrorIs(t, err, types.ErrInvalidOriginDomain)
+	require.NoError(t, keeper.SetOriginDomain(ctx, types.OriginDomain{
+		DomainId:       1,
+		Name:           "ethereum",
+		Mailbox:        "0x" + strings.Repeat("ab", 32),
+		MerkleTreeHook: "0x" + strings.Repeat("cd", 32),
+	}))
+	_, err = msgServer.BindCheckpointKey(ctx, bindMsg(valAddr, consPriv, domainPriv, 1, 1))
+	require.NoError(t, err)
+	meta, _, err = keeper.GetDutyMetadata(ctx, consAddr)
+	require.NoError(t, err)
+	assert.Equal(t, key, meta.CheckpointPubKey)
+	domainPubKey, _ := meta.CheckpointConfigFor(1)
+	assert.Equal(t, domainKey, domainPubKey)
+
+	// Binding a new default key schedules it behind the rotation delay
+	newPriv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	newKey := "0x" + hex.EncodeToString(newPriv.PubKey().SerializeCompressed())
+	_, err = msgServer.BindCheckpointKey(ctx, bindMsg(valAddr, consPriv, newPriv, 0, 2))
+	require.NoError(t, err)
+	meta, _, err = keeper.GetDutyMetadata(ctx, consAddr)
+	require.NoError(t, err)
+	assert.Equal(t, key, meta.CheckpointPubKey)
+	require.NotNil(t, meta.PendingKey)
+	assert.Equal(t, newKey, meta.PendingKey.CheckpointPubKey)
+	assert.Equal(t, ctx.BlockHeight()+int64(types.DefaultKeyRotationDelay), meta.PendingKey.ActivationHeight)
+	assert.False(t, meta.IsRetiredKey(key, ctx.BlockHeight()+1))
+}
+
+func TestMsgServer_RotateCheckpointKey(t *testing.T) {
+	validator, consPriv, valAddr := newTestValidator(t)
+	keeper, ctx := setupTestKeeperWithStaking(t, newMockStakingKeeper(validator))
+	ctx = ctx.WithChainID("duty-test").WithBlockHeight(5)
+	msgServer := NewMsgServerImpl(keeper)
+
+	consAddr := sdk.ConsAddress(consPriv.PubKey().Address())
+	newKey := func() (*secp256k1.PrivateKey, string) {
+		priv, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		return priv, "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	}
+	_, oldKey := newKey()
+	var meta types.DutyMetadata
+	meta.SetCheckpointKey(oldKey, 1)
+	require.NoError(t, keeper.SetDutyMetadata(ctx, consAddr, meta))
+
+	rotatePriv, rotateKey := newKey()
+	rotateMsg := func(signer *secp256k1.PrivateKey, chainID, oldKey string, nonce uint64) *types.MsgRotateCheckpointKey {
+		return &types.MsgRotateCheckpointKey{
+			Signer:               valAddr.String(),
+			NewCheckpointPubKey:  rotateKey,
+			AttestationSignature: signEthMessage(signer, types.RotateCheckpointKeyPayload(chainID, consAddr, oldKey, rotateKey, nonce)),
+			Nonce:                nonce,
+		}
+	}
+
+	t.Run("signed by another key", func(t *testing.T) {
+		otherPriv, _ := newKey()
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.RotateCheckpointKey(cacheCtx, rotateMsg(otherPriv, ctx.ChainID(), oldKey, 0))
+		assert.ErrorIs(t, err, types.ErrInvalidAttestation)
+	})
+
+	t.Run("wrong old key", func(t *testing.T) {
+		_, otherKey := newKey()
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.RotateCheckpointKey(cacheCtx, rotateMsg(rotatePriv, ctx.ChainID(), otherKey, 0))
+		assert.ErrorIs(t, err, types.ErrInvalidAttestation)
+	})
+
+	t.Run("wrong chain ID", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.RotateCheckpointKey(cacheCtx, rotateMsg(rotatePriv, "other-chain", oldKey, 0))
+		assert.ErrorIs(t, err, types.ErrInvalidAttestation)
+	})
+
+	t.Run("future nonce", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.RotateCheckpointKey(cacheCtx, rotateMsg(rotatePriv, ctx.ChainID(), oldKey, 1))
+		assert.ErrorIs(t, err, types.ErrInvalidNonce)
+	})
+
+	// A valid rotation is scheduled behind the rotation delay
+	_, err := msgServer.RotateCheckpointKey(ctx, rotateMsg(rotatePriv, ctx.ChainID(), oldKey, 0))
+	require.NoError(t, err)
+	meta, _, err = keeper.GetDutyMetadata(ctx, consAddr)
+	require.NoError(t, err)
+	assert.Equal(t, oldKey, meta.CheckpointPubKey)
+	require.NotNil(t, meta.PendingKey)
+	assert.Equal(t, rotateKey, meta.PendingKey.CheckpointPubKey)
+	assert.Equal(t, ctx.BlockHeight()+int64(types.DefaultKeyRotationDelay), meta.PendingKey.ActivationHeight)
+
+	// Replaying the same rotation fails on the advanced nonce
+	_, err = msgServer.RotateCheckpointKey(ctx, rotateMsg(rotatePriv, ctx.ChainID(), oldKey, 0))
+	assert.ErrorIs(t, err, types.ErrInvalidNonce)
+}
+
+func TestMsgServer_SetDutyMetadata(t *testing.T) {
+	validator, consPriv, valAddr := newTestValidator(t)
+	keeper, ctx := setupTestKeeperWithStaking(t, newMockStakingKeeper(validator))
+	ctx = ctx.WithBlockHeight(5)
+	msgServer := NewMsgServerImpl(keeper)
+	consAddr := sdk.ConsAddress(consPriv.PubKey().Address())
+
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	key := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	otherPriv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	otherKey := "0x" + hex.EncodeToString(otherPriv.PubKey().SerializeCompressed())
+
+	// Without a bound key, no key can be set
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.SetDutyMetadata(cacheCtx, &types.MsgSetDutyMetadata{
+		Signer:   valAddr.String(),
+		Metadata: types.DutyMetadata{CheckpointPubKey: key, CheckpointStorageUri: "s3://bucket/a"},
+	})
+	assert.ErrorIs(t, err, types.ErrCheckpointKeyChange)
+
+	// Storage URIs can be set before binding
+	_, err = msgServer.SetDutyMetadata(ctx, &types.MsgSetDutyMetadata{
+		Signer:   valAddr.String(),
+		Metadata: types.DutyMetadata{CheckpointStorageUri: "s3://bucket/a"},
+	})
+	require.NoError(t, err)
+
+	meta := types.DutyMetadata{CheckpointStorageUri: "s3://bucket/a"}
+	meta.SetCheckpointKey(key, 1)
+	meta.SetDomainCheckpointKey(1, otherKey)
+	require.NoError(t, keeper.SetDutyMetadata(ctx, consAddr, meta))
+
+	// Neither the default nor a per-domain key can change
+	for _, update := range []types.DutyMetadata{
+		{CheckpointPubKey: otherKey, CheckpointStorageUri: "s3://bucket/b"},
+		{CheckpointStorageUri: "s3://bucket/b", DomainConfigs: []*types.DomainCheckpointConfig{{OriginDomain: 1, CheckpointPubKey: key}}},
+		{CheckpointStorageUri: "s3://bucket/b", DomainConfigs: []*types.DomainCheckpointConfig{{OriginDomain: 2, CheckpointPubKey: otherKey}}},
+	} {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err = msgServer.SetDutyMetadata(cacheCtx, &types.MsgSetDutyMetadata{Signer: valAddr.String(), Metadata: update, Nonce: 1})
+		assert.ErrorIs(t, err, types.ErrCheckpointKeyChange)
+	}
+
+	// Another validator's key cannot be squatted through metadata
+	squatter, squatterConsPriv, squatterValAddr := newTestValidator(t)
+	keeper.stakingKeeper = newMockStakingKeeper(validator, squatter)
+	msgServer = NewMsgServerImpl(keeper)
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.SetDutyMetadata(cacheCtx, &types.MsgSetDutyMetadata{
+		Signer:   squatterValAddr.String(),
+		Metadata: types.DutyMetadata{CheckpointPubKey: key, CheckpointStorageUri: "s3://bucket/squat"},
+	})
+	assert.ErrorIs(t, err, types.ErrCheckpointKeyChange)
+	addr, err := types.CheckpointAddress(key)
+	require.NoError(t, err)
+	owner, found, err := keeper.GetConsAddrByCheckpointAddress(cacheCtx, addr)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, consAddr, owner)
+	_, found, err = keeper.GetDutyMetadata(cacheCtx, sdk.ConsAddress(squatterConsPriv.PubKey().Address()))
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// The registered key may be repeated; per-domain keys are kept
+	_, err = msgServer.SetDutyMetadata(ctx, &types.MsgSetDutyMetadata{
+		Signer: valAddr.String(),
+		Metadata: types.DutyMetadata{
+			CheckpointPubKey:     key,
+			CheckpointStorageUri: "s3://bucket/b",
+			DomainConfigs:        []*types.DomainCheckpointConfig{{OriginDomain: 2, CheckpointStorageUri: "gs://bucket/two"}},
+		},
+		Nonce: 1,
+	})
+	require.NoError(t, err)
+	stored, _, err := keeper.GetDutyMetadata(ctx, consAddr)
+	require.NoError(t, err)
+	assert.Equal(t, key, stored.CheckpointPubKey)
+	assert.Equal(t, int64(1), stored.CheckpointKeyValidFrom)
+	assert.Equal(t, "s3://bucket/b", stored.CheckpointStorageUri)
+	domainKey, _ := stored.CheckpointConfigFor(1)
+	assert.Equal(t, otherKey, domainKey)
+	_, domainURI := stored.CheckpointConfigFor(2)
+	assert.Equal(t, "gs://bucket/two", domainURI)
+}
+
+// checkpointSigner is a member of a test duty set with its checkpoint key.
+type checkpointSigner struct {
+	consAddr sdk.ConsAddress
+	priv     *secp256k1.PrivateKey
+}
+
+// setupCheckpointDutySet commits a duty set of n validators with equal power
+// and a checkpoint key each as epoch 1, without a staking keeper.
+func setupCheckpointDutySet(t *testing.T, keeper Keeper, ctx sdk.Context, n int) []checkpointSigner {
+	signers := make([]checkpointSigner, n)
+	validators := make([]*types.DutyValidator, n)
+	for i := range signers {
+		priv, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		signers[i] = checkpointSigner{consAddr: sdk.ConsAddress([]byte(fmt.Sprintf("checkpoint-signer-%d", i))), priv: priv}
+		validators[i] = &types.DutyValidator{
+			ValConsAddr:      signers[i].consAddr.String(),
+			VotingPower:      "10",
+			CheckpointPubKey: "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed()),
+		}
+	}
+	require.NoError(t, keeper.SetDutySetSnapshot(ctx, types.DutySetSnapshot{
+		Epoch:      1,
+		Height:     ctx.BlockHeight(),
+		Validators: validators,
+		QuorumNum:  types.DefaultQuorumNum,
+		QuorumDen:  types.DefaultQuorumDen,
+	}))
+	return signers
+}
+
+// signCheckpoint returns a signed checkpoint message for index on domain 1.
+func signCheckpoint(t *testing.T, signer checkpointSigner, index uint32, root string) *types.MsgSubmitCheckpointSignature {
+	msg := &types.MsgSubmitCheckpointSignature{
+		OriginDomain:   1,
+		MerkleTreeHook: "0x" + strings.Repeat("cd", 32),
+		Root:           root,
+		Index:          index,
+		MessageId:      "0x" + strings.Repeat("ef", 32),
+	}
+	digest, err := msg.Digest()
+	require.NoError(t, err)
+	msg.Signature = signEthMessage(signer.priv, digest)
+	return msg
+}
+
+func TestKeeper_AddCheckpointSignature(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	signers := setupCheckpointDutySet(t, keeper, ctx, 4)
+	root := "0x" + strings.Repeat("aa", 32)
+
+	quorumEvents := func(ctx sdk.Context) int {
+		n := 0
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == "duty_checkpoint_quorum_reached" {
+				n++
+			}
+		}
+		return n
+	}
+
+	// Power and signature count accumulate; 2/3 of 40 needs three signers
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	for i, signer := range signers[:2] {
+		cp, err := keeper.AddCheckpointSignature(ctx, signer.consAddr, signCheckpoint(t, signer, 0, root))
+		require.NoError(t, err)
+		assert.Len(t, cp.Signatures, i+1)
+		assert.Equal(t, fmt.Sprintf("%d", 10*(i+1)), cp.SignedPower)
+		assert.Equal(t, "40", cp.TotalPower)
+		assert.False(t, cp.QuorumReached)
+	}
+
+	// A validator cannot sign the same checkpoint twice
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := keeper.AddCheckpointSignature(cacheCtx, signers[0].consAddr, signCheckpoint(t, signers[0], 0, root))
+	assert.ErrorIs(t, err, types.ErrDuplicateSignature)
+
+	// A signer outside the duty set is rejected
+	outsiderPriv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	outsider := checkpointSigner{consAddr: sdk.ConsAddress([]byte("outside-signer")), priv: outsiderPriv}
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = keeper.AddCheckpointSignature(cacheCtx, outsider.consAddr, signCheckpoint(t, outsider, 0, root))
+	assert.ErrorIs(t, err, types.ErrNotInDutySet)
+
+	// The third signature reaches quorum
+	cp, err := keeper.AddCheckpointSignature(ctx, signers[2].consAddr, signCheckpoint(t, signers[2], 0, root))
+	require.NoError(t, err)
+	assert.True(t, cp.QuorumReached)
+	assert.Equal(t, int64(10), cp.QuorumHeight)
+	assert.Equal(t, "30", cp.SignedPower)
+	assert.Equal(t, 1, quorumEvents(ctx))
+
+	// Later signatures still count but quorum is only reached once
+	cp, err = keeper.AddCheckpointSignature(ctx.WithBlockHeight(12), signers[3].consAddr, signCheckpoint(t, signers[3], 0, root))
+	require.NoError(t, err)
+	assert.Len(t, cp.Signatures, 4)
+	assert.Equal(t, "40", cp.SignedPower)
+	assert.Equal(t, int64(10), cp.QuorumHeight)
+	assert.Equal(t, 1, quorumEvents(ctx))
+
+	digest, err := types.DecodeHex(cp.Digest)
+	require.NoError(t, err)
+	stored, found, err := keeper.GetCheckpoint(ctx, 1, 0, digest)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, cp, stored)
+}
+
+func TestKeeper_CheckpointLivenessAfterGracePeriod(t *testing.T) {
+	keeper, ctx := setupTestKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	signers := setupCheckpointDutySet(t, keeper, ctx, 3)
+	root := "0x" + strings.Repeat("aa", 32)
+
+	// Two of three signers reach quorum at height 10
+	ctx = ctx.WithBlockHeight(10)
+	for _, signer := range signers[:2] {
+		_, err := keeper.AddCheckpointSignature(ctx, signer.consAddr, signCheckpoint(t, signer, 0, root))
+		require.NoError(t, err)
+	}
+	cp, err := keeper.AddCheckpointSignature(ctx.WithBlockHeight(15), signers[2].consAddr, signCheckpoint(t, signers[2], 0, root))
+	require.NoError(t, err)
+	assert.True(t, cp.QuorumReached)
+	assert.Equal(t, int64(10), cp.QuorumHeight)
+	deadline := int64(10) + int64(types.DefaultCheckpointSigningGracePeriod)
+	assert.Equal(t, deadline, cp.LivenessDeadline)
+
+	// Nothing is recorded before the deadline
+	require.NoError(t, keeper.RecordDueCheckpointLiveness(ctx.WithBlockHeight(deadline-1)))
+	_, found, err := keeper.GetCheckpointSigningInfo(ctx, signers[2].consAddr)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// The late signer counts as signed once the deadline passes
+	require.NoError(t, keeper.RecordDueCheckpointLiveness(ctx.WithBlockHeight(deadline)))
+	for _, signer := range signers {
+		info, found, err := keeper.GetCheckpointSigningInfo(ctx, signer.consAddr)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, int64(1), info.IndexOffset)
+		assert.Zero(t, info.MissedCheckpointsCounter)
+	}
+
+	// A validator that does not sign within the grace period is missed
+	ctx = ctx.WithBlockHeight(40)
+	for _, signer := range signers[:2] {
+		_, err := keeper.AddCheckpointSignature(ctx, signer.consAddr, signCheckpoint(t, signer, 1, root))
+		require.NoError(t, err)
+	}
+	deadline = 40 + int64(types.DefaultCheckpointSigningGracePeriod)
+	require.NoError(t, keeper.RecordDueCheckpointLiveness(ctx.WithBlockHeight(deadline)))
+	info, _, err := keeper.GetCheckpointSigningInfo(ctx, signers[2].consAddr)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), info.MissedCheckpointsCounter)
+
+	// Each checkpoint is recorded once
+	require.NoError(t, keeper.RecordDueCheckpointLiveness(ctx.WithBlockHeight(deadline+1)))
+	info, _, err = keeper.GetCheckpointSigningInfo(ctx, signers[0].consAddr)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), info.IndexOffset)
+}
+
+func TestMsgServer_SubmitCheckpointEquivocation(t *testing.T) {
+	validator, consPriv, _ := newTestValidator(t)
+	stakingKeeper := newMockStakingKeeper(validator)
+	keeper, ctx := setupTestKeeperWithStaking(t, stakingKeeper)
+	slashingKeeper := newMockSlashingKeeper()
+	keeper.slashingKeeper = slashingKeeper
+	msgServer := NewMsgServerImpl(keeper)
+
+	consAddr := sdk.ConsAddress(consPriv.PubKey().Address())
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	signer := checkpointSigner{consAddr: consAddr, priv: priv}
+	require.NoError(t, keeper.SetDutyMetadata(ctx, consAddr, types.DutyMetadata{
+		CheckpointPubKey: "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed()),
+	}))
+
+	signedAt := func(signer checkpointSigner, index uint32, root string) types.SignedCheckpoint {
+		msg := signCheckpoint(t, signer, index, root)
+		return types.SignedCheckpoint{
+			OriginDomain:   msg.OriginDomain,
+			MerkleTreeHook: msg.MerkleTreeHook,
+			Root:           msg.Root,
+			Index:          msg.Index,
+			MessageId:      msg.MessageId,
+			Signature:      msg.Signature,
+		}
+	}
+	signed := func(signer checkpointSigner, root string) types.SignedCheckpoint {
+		return signedAt(signer, 7, root)
+	}
+	evidence := func(first, second types.SignedCheckpoint) *types.MsgSubmitCheckpointEquivocation {
+		return &types.MsgSubmitCheckpointEquivocation{
+			Submitter: sdk.AccAddress([]byte("submitter")).String(),
+			First:     first,
+			Second:    second,
+		}
+	}
+	rootA := "0x" + strings.Repeat("aa", 32)
+	rootB := "0x" + strings.Repeat("bb", 32)
+
+	t.Run("same root twice", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.SubmitCheckpointEquivocation(cacheCtx, evidence(signed(signer, rootA), signed(signer, rootA)))
+		assert.ErrorIs(t, err, types.ErrInvalidEquivocation)
+		assert.False(t, slashingKeeper.IsTombstoned(ctx, consAddr))
+	})
+
+	t.Run("different indexes", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.SubmitCheckpointEquivocation(cacheCtx, evidence(signedAt(signer, 4, rootA), signedAt(signer, 5, rootB)))
+		assert.ErrorIs(t, err, types.ErrInvalidEquivocation)
+		assert.False(t, stakingKeeper.slashed[consAddr.String()])
+		assert.False(t, slashingKeeper.IsTombstoned(ctx, consAddr))
+	})
+
+	t.Run("different merkle tree hooks", func(t *testing.T) {
+		second := signed(signer, rootB)
+		second.MerkleTreeHook = "0x" + strings.Repeat("01", 32)
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.SubmitCheckpointEquivocation(cacheCtx, evidence(signed(signer, rootA), second))
+		assert.ErrorIs(t, err, types.ErrInvalidEquivocation)
+		assert.False(t, stakingKeeper.slashed[consAddr.String()])
+	})
+
+	t.Run("unknown signer", func(t *testing.T) {
+		unknownPriv, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		unknown := checkpointSigner{consAddr: sdk.ConsAddress([]byte("unknown-signer")), priv: unknownPriv}
+		cacheCtx, _ := ctx.CacheContext()
+		_, err = msgServer.SubmitCheckpointEquivocation(cacheCtx, evidence(signed(unknown, rootA), signed(unknown, rootB)))
+		assert.ErrorIs(t, err, types.ErrInvalidEquivocation)
+	})
+
+	t.Run("different signers", func(t *testing.T) {
+		otherPriv, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		other := checkpointSigner{consAddr: consAddr, priv: otherPriv}
+		cacheCtx, _ := ctx.CacheContext()
+		_, err = msgServer.SubmitCheckpointEquivocation(cacheCtx, evidence(signed(signer, rootA), signed(other, rootB)))
+		assert.ErrorIs(t, err, types.ErrInvalidEquivocation)
+	})
+
+	// Two roots signed for the same index tombstone the validator
+	_, err = msgServer.SubmitCheckpointEquivocation(ctx, evidence(signed(signer, rootA), signed(signer, rootB)))
+	require.NoError(t, err)
+	assert.True(t, stakingKeeper.slashed[consAddr.String()])
+	assert.True(t, slashingKeeper.IsTombstoned(ctx, consAddr))
+
+	// The same evidence cannot punish the validator twice
+	_, err = msgServer.SubmitCheckpointEquivocation(ctx, evidence(signed(signer, rootA), signed(signer, rootB)))
+	assert.ErrorIs(t, err, types.ErrInvalidEquivocation)
+}
@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// SetCheckpointSigningInfo stores a validator's checkpoint liveness record.
+func (k Keeper) SetCheckpointSigningInfo(ctx sdk.Context, valConsAddr sdk.ConsAddress, info types.CheckpointSigningInfo) error {
+	return k.CheckpointSigningInfos.Set(ctx, valConsAddr, info)
+}
+
+// GetCheckpointSigningInfo returns a validator's checkpoint liveness record.
+func (k Keeper) GetCheckpointSigningInfo(ctx sdk.Context, valConsAddr sdk.ConsAddress) (types.CheckpointSigningInfo, bool, error) {
+	return lookup(k.CheckpointSigningInfos.Get(ctx, valConsAddr))
+}
+
+func (k Keeper) getCheckpointMissed(ctx sdk.Context, valConsAddr sdk.ConsAddress, index int64) (bool, error) {
+	return k.CheckpointMissed.Has(ctx, collections.Join(valConsAddr, uint64(index)))
+}
+
+func (k Keeper) setCheckpointMissed(ctx sdk.Context, valConsAddr sdk.ConsAddress, index int64, missed bool) error {
+	key := collections.Join(valConsAddr, uint64(index))
+	if missed {
+		return k.CheckpointMissed.Set(ctx, key)
+	}
+	return k.CheckpointMissed.Remove(ctx, key)
+}
+
+func (k Keeper) clearCheckpointMissed(ctx sdk.Context, valConsAddr sdk.ConsAddress) error {
+	rng := collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](valConsAddr)
+	iter, err := k.CheckpointMissed.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.CheckpointMissed.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordDueCheckpointLiveness records liveness for every queued quorum
+// checkpoint whose deadline is at or before the current height, counting all
+// signatures collected until then. Checkpoints whose duty set has been pruned
+// are dropped without a record.
+func (k Keeper) RecordDueCheckpointLiveness(ctx sdk.Context) error {
+	type dueCheckpoint struct {
+		key collections.Pair[int64, []byte]
+		ref collections.Pair[uint32, uint32]
+	}
+	var due []dueCheckpoint
+	rng := collections.NewPrefixUntilPairRange[int64, []byte](ctx.BlockHeight())
+	err := k.CheckpointLivenessQueue.Walk(ctx, rng, func(key collections.Pair[int64, []byte], ref collections.Pair[uint32, uint32]) (bool, error) {
+		due = append(due, dueCheckpoint{key: key, ref: ref})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, d := range due {
+		if err := k.CheckpointLivenessQueue.Remove(ctx, d.key); err != nil {
+			return err
+		}
+		cp, found, err := k.GetCheckpoint(ctx, d.ref.K1(), d.ref.K2(), d.key.K2())
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		dutySet, found, err := k.GetDutySetByEpoch(ctx, cp.Epoch)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		domain, registered, err := k.GetOriginDomain(ctx, cp.OriginDomain)
+		if err != nil {
+			return err
+		}
+		if registered {
+			dutySet = dutySet.ForDomain(cp.OriginDomain, &domain)
+		} else {
+			dutySet = dutySet.ForDomain(cp.OriginDomain, nil)
+		}
+		if err := k.HandleCheckpointLiveness(ctx, dutySet, cp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleCheckpointLiveness records, for every member of the checkpoint's duty
+// set that has a checkpoint key, whether it signed a quorum checkpoint whose
+// signing grace period has ended. dutySet must already be resolved for the
+// checkpoint's origin domain. Members whose consensus key was rotated since
+// the set was committed are recorded under their current address.
+func (k Keeper) HandleCheckpointLiveness(ctx sdk.Context, dutySet types.DutySetSnapshot, cp types.Checkpoint) error {
+	signed := make(map[string]bool, len(cp.Signatures))
+	for _, sig := range cp.Signatures {
+		signed[sig.ValConsAddr] = true
+	}
+	for _, v := range dutySet.Validators {
+		if v.CheckpointPubKey == "" {
+			continue
+		}
+		consAddr, err := sdk.ConsAddressFromBech32(v.ValConsAddr)
+		if err != nil {
+			continue
+		}
+		if consAddr, err = k.CurrentConsAddress(ctx, consAddr); err != nil {
+			return err
+		}
+		if err := k.handleValidatorCheckpoint(ctx, consAddr, signed[v.ValConsAddr]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleValidatorCheckpoint advances a validator's signing window by one
+// checkpoint and jails (and optionally slashes) it once it has missed more
+// than the window allows.
+func (k Keeper) handleValidatorCheckpoint(ctx sdk.Context, consAddr sdk.ConsAddress, signed bool) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	window := params.SignedCheckpointsWindow
+	if window == 0 {
+		return nil
+	}
+
+	info, found, err := k.GetCheckpointSigningInfo(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+	if !found {
+		info = types.CheckpointSigningInfo{
+			ValConsAddr: consAddr.String(),
+			StartHeight: ctx.BlockHeight(),
+		}
+	}
+
+	index := info.IndexOffset % window
+	info.IndexOffset++
+
+	previous, err := k.getCheckpointMissed(ctx, consAddr, index)
+	if err != nil {
+		return err
+	}
+	switch {
+	case !previous && !signed:
+		if err := k.setCheckpointMissed(ctx, consAddr, index, true); err != nil {
+			return err
+		}
+		info.MissedCheckpointsCounter++
+	case previous && signed:
+		if err := k.setCheckpointMissed(ctx, consAddr, index, false); err != nil {
+			return err
+		}
+		info.MissedCheckpointsCounter--
+	}
+
+	if !signed {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent("duty_checkpoint_missed",
+				sdk.NewAttribute("cons_addr", consAddr.String()),
+				sdk.NewAttribute("missed_checkpoints", fmt.Sprintf("%d", info.MissedCheckpointsCounter)),
+				sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+			),
+		)
+	}
+
+	minSigned := params.MinSignedPerWindow
+	if minSigned.IsNil() {
+		minSigned = math.LegacyZeroDec()
+	}
+	maxMissed := window - minSigned.MulInt64(window).RoundInt64()
+
+	if info.IndexOffset >= window && info.MissedCheckpointsCounter > maxMissed {
+		if validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr); err == nil && !validator.IsJailed() {
+			power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+			slashFraction := params.SlashFractionMissedCheckpoints
+			if !slashFraction.IsNil() && slashFraction.IsPositive() {
+				if _, err := k.stakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, slashFraction); err != nil {
+					return err
+				}
+			}
+			if err := k.stakingKeeper.Jail(ctx, consAddr); err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent("duty_liveness_jailed",
+					sdk.NewAttribute("cons_addr", consAddr.String()),
+					sdk.NewAttribute("val_addr", validator.GetOperator()),
+					sdk.NewAttribute("missed_checkpoints", fmt.Sprintf("%d", info.MissedCheckpointsCounter)),
+					sdk.NewAttribute("power", fmt.Sprintf("%d", power)),
+					sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+				),
+			)
+		}
+
+		// Start a fresh window so the validator is not jailed again right
+		// after unjailing
+		info.StartHeight = ctx.BlockHeight()
+		info.IndexOffset = 0
+		info.MissedCheckpointsCounter = 0
+		if err := k.clearCheckpointMissed(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	return k.SetCheckpointSigningInfo(ctx, consAddr, info)
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/exported"
+	v2 "github.com/TheArticulation/Duty/x/duty/migrations/v2"
+	v3 "github.com/TheArticulation/Duty/x/duty/migrations/v3"
+	v4 "github.com/TheArticulation/Duty/x/duty/migrations/v4"
+	v5 "github.com/TheArticulation/Duty/x/duty/migrations/v5"
+	v7 "github.com/TheArticulation/Duty/x/duty/migrations/v7"
+)
+
+// Migrator performs in-place store migrations for the duty module.
+type Migrator struct {
+	keeper         Keeper
+	legacySubspace exported.Subspace
+}
+
+func NewMigrator(k Keeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: k, legacySubspace: legacySubspace}
+}
+
+// Migrate1to2 re-encodes DutyMetadata from JSON to protobuf and builds the
+// CheckpointAddress index over the migrated entries.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc); err != nil {
+		return err
+	}
+	return m.keeper.rebuildCheckpointAddressIndex(ctx)
+}
+
+// Migrate2to3 moves params from the legacy subspace and params.Service keys
+// into the params item.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc, m.legacySubspace)
+}
+
+// Migrate3to4 sets the max_storage_uri_length param added in v4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate4to5 sets the key_rotation_delay param added in v5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate5to6 records the operator of every validator with duty metadata, so
+// consensus key rotations are detected for state written before v6, and
+// moves storage announcements out of DutyMetadata into their own store.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	if err := m.keeper.trackValidatorConsAddrs(ctx); err != nil {
+		return err
+	}
+	return m.keeper.moveStorageAnnouncements(ctx)
+}
+
+// Migrate6to7 sets the checkpoint_signing_grace_period param added in v7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
@@ -3,46 +3,109 @@ package keeper
 import (
 	context "context"
 	"fmt"
-	"yourapp/x/duty/types"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
 )
 
 type msgServer struct{ k Keeper }
 
 func NewMsgServerImpl(k Keeper) types.MsgServer { return &msgServer{k: k} }
 
+// validatorByOperator returns the validator operated by valAddr and its
+// consensus address.
+func (s *msgServer) validatorByOperator(ctx sdk.Context, valAddr sdk.ValAddress) (stakingtypes.Validator, sdk.ConsAddress, error) {
+	v, err := s.k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return stakingtypes.Validator{}, nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "no validator")
+	}
+	consAddr, err := v.GetConsAddr()
+	if err != nil {
+		return stakingtypes.Validator{}, nil, err
+	}
+	return v, consAddr, nil
+}
+
 func (s *msgServer) SetDutyMetadata(goCtx context.Context, msg *types.MsgSetDutyMetadata) (*emptypb.Empty, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	valAddr, err := sdk.ValAddressFromBech32(msg.Signer)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "invalid valoper")
+		return nil, errorsmod.Wrap(err, "invalid valoper")
 	}
 	// Only allow the validator operator to set metadata for their own consensus key
-	v, found := s.k.stakingKeeper.GetValidator(ctx, valAddr)
-	if !found {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no validator")
+	_, consAddr, err := s.validatorByOperator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.k.useDutyNonce(ctx, consAddr, msg.Nonce); err != nil {
+		return nil, err
 	}
-	consAddr, _ := v.GetConsAddr()
 
-	// Convert proto DutyMetadata to internal DutyMetadata
-	metadata := types.DutyMetadata{
-		CheckpointPubKey:     msg.Metadata.CheckpointPubKey,
-		CheckpointStorageURI: msg.Metadata.CheckpointStorageUri,
+	// Only the storage URIs change here; keys keep their history and any
+	// pending rotation, and earlier announcements survive as long as they
+	// still match
+	existingMeta, _, err := s.k.GetDutyMetadata(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := existingMeta.WithStorageURIs(msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	params, err := s.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := metadata.ValidateStorageURIs(params.MaxStorageUriLength); err != nil {
+		return nil, err
+	}
+	announced := make([]types.StorageAnnouncement, 0, len(msg.Announcements))
+	for _, a := range msg.Announcements {
+		stored, err := s.k.verifyAnnouncement(ctx, metadata, *a)
+		if err != nil {
+			return nil, err
+		}
+		announced = append(announced, stored)
 	}
 
-	s.k.SetDutyMetadata(ctx, consAddr, metadata)
+	if err := s.k.SetDutyMetadata(ctx, consAddr, metadata); err != nil {
+		return nil, err
+	}
+	if err := s.k.SetValidatorConsAddr(ctx, valAddr, consAddr); err != nil {
+		return nil, err
+	}
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent("duty_metadata_set",
 			sdk.NewAttribute("cons_addr", consAddr.String()),
 			sdk.NewAttribute("val_addr", valAddr.String()),
 			sdk.NewAttribute("checkpoint_pub_key", metadata.CheckpointPubKey),
-			sdk.NewAttribute("storage_uri", metadata.CheckpointStorageURI),
+			sdk.NewAttribute("storage_uri", metadata.CheckpointStorageUri),
 			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
 		),
 	)
+	for _, a := range announced {
+		added, err := s.k.AddStorageAnnouncement(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		if !added {
+			continue
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent("duty_storage_announced",
+				sdk.NewAttribute("cons_addr", consAddr.String()),
+				sdk.NewAttribute("origin_domain", fmt.Sprintf("%d", a.OriginDomain)),
+				sdk.NewAttribute("validator", a.Validator),
+				sdk.NewAttribute("storage_location", a.StorageLocation),
+				sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+			),
+		)
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -50,41 +113,66 @@ func (s *msgServer) RotateCheckpointKey(goCtx context.Context, msg *types.MsgRot
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	valAddr, err := sdk.ValAddressFromBech32(msg.Signer)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "invalid valoper")
+		return nil, errorsmod.Wrap(err, "invalid valoper")
 	}
 
 	// Only allow the validator operator to rotate their own checkpoint key
-	v, found := s.k.stakingKeeper.GetValidator(ctx, valAddr)
-	if !found {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no validator")
+	_, consAddr, err := s.validatorByOperator(ctx, valAddr)
+	if err != nil {
+		return nil, err
 	}
 
-	consAddr, _ := v.GetConsAddr()
-
 	// Get existing metadata
-	existingMeta, found := s.k.GetDutyMetadata(ctx, consAddr)
+	existingMeta, found, err := s.k.GetDutyMetadata(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no existing duty metadata")
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no existing duty metadata")
 	}
 
-	// TODO: Verify attestation signature
-	// This would verify that the new key is owned by the validator
-	// For now, we'll just update the key
+	// The new key must attest to this exact rotation
+	if err := s.k.useDutyNonce(ctx, consAddr, msg.Nonce); err != nil {
+		return nil, err
+	}
+	payload := types.RotateCheckpointKeyPayload(ctx.ChainID(), consAddr, existingMeta.CheckpointPubKey, msg.NewCheckpointPubKey, msg.Nonce)
+	if err := types.VerifyCheckpointSignature(msg.NewCheckpointPubKey, payload, msg.AttestationSignature); err != nil {
+		return nil, err
+	}
+	newKey, err := types.NormalizeCheckpointKey(msg.NewCheckpointPubKey)
+	if err != nil {
+		return nil, err
+	}
+	// Only a proven key claims the checkpoint address index
+	if err := s.k.claimCheckpointKey(ctx, consAddr, newKey); err != nil {
+		return nil, err
+	}
 
-	// Update metadata with new checkpoint key
-	updatedMeta := types.DutyMetadata{
-		CheckpointPubKey:     msg.NewCheckpointPubKey,
-		CheckpointStorageURI: existingMeta.CheckpointStorageURI,
+	// The new key is pending until the rotation delay has passed; both keys
+	// sign in the meantime and EndBlock promotes the new one. A rotation
+	// that is already pending is replaced.
+	params, err := s.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
 	}
+	activationHeight := ctx.BlockHeight() + int64(params.KeyRotationDelay)
+	updatedMeta := existingMeta
+	updatedMeta.ScheduleKeyRotation(newKey, ctx.BlockHeight(), activationHeight)
 
-	s.k.SetDutyMetadata(ctx, consAddr, updatedMeta)
+	if err := s.k.SetDutyMetadata(ctx, consAddr, updatedMeta); err != nil {
+		return nil, err
+	}
+	if err := s.k.SetValidatorConsAddr(ctx, valAddr, consAddr); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent("duty_checkpoint_key_rotated",
 			sdk.NewAttribute("cons_addr", consAddr.String()),
 			sdk.NewAttribute("val_addr", valAddr.String()),
 			sdk.NewAttribute("old_checkpoint_pub_key", existingMeta.CheckpointPubKey),
-			sdk.NewAttribute("new_checkpoint_pub_key", msg.NewCheckpointPubKey),
+			sdk.NewAttribute("new_checkpoint_pub_key", newKey),
+			sdk.NewAttribute("activation_height", fmt.Sprintf("%d", activationHeight)),
 			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
 		),
 	)
@@ -96,48 +184,218 @@ func (s *msgServer) BindCheckpointKey(goCtx context.Context, msg *types.MsgBindC
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	valAddr, err := sdk.ValAddressFromBech32(msg.Signer)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "invalid valoper")
+		return nil, errorsmod.Wrap(err, "invalid valoper")
 	}
 
 	// Parse consensus address
 	consAddr, err := sdk.ConsAddressFromBech32(msg.ConsensusAddress)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "invalid consensus address")
+		return nil, errorsmod.Wrap(err, "invalid consensus address")
 	}
 
 	// Verify the validator exists and the signer is the operator
-	v, found := s.k.stakingKeeper.GetValidator(ctx, valAddr)
-	if !found {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no validator")
+	v, validatorConsAddr, err := s.validatorByOperator(ctx, valAddr)
+	if err != nil {
+		return nil, err
 	}
 
 	// Verify the consensus address matches the validator's consensus address
-	validatorConsAddr, _ := v.GetConsAddr()
 	if !consAddr.Equals(validatorConsAddr) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "consensus address mismatch")
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "consensus address mismatch")
+	}
+
+	if err := s.k.useDutyNonce(ctx, consAddr, msg.Nonce); err != nil {
+		return nil, err
+	}
+
+	if msg.OriginDomain != 0 {
+		_, found, err := s.k.GetOriginDomain(ctx, msg.OriginDomain)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, types.ErrInvalidOriginDomain.Wrapf("domain %d is not registered", msg.OriginDomain)
+		}
 	}
 
-	// TODO: Verify binding signature
-	// This would verify that the checkpoint key is bound to the consensus address
-	// For now, we'll just create the binding
+	// The checkpoint key must sign over the consensus address...
+	payload := types.BindCheckpointKeyPayload(ctx.ChainID(), consAddr, msg.OriginDomain, msg.Nonce)
+	if err := types.VerifyCheckpointSignature(msg.CheckpointPubKey, payload, msg.BindingSignature); err != nil {
+		return nil, types.ErrInvalidBinding.Wrap(err.Error())
+	}
+
+	// ...and the consensus key must sign over the checkpoint key
+	consPubKey, err := v.ConsPubKey()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid consensus pubkey")
+	}
+	consSig, err := types.DecodeHex(msg.ConsensusSignature)
+	if err != nil {
+		return nil, types.ErrInvalidBinding.Wrapf("consensus signature not hex: %s", err)
+	}
+	if !consPubKey.VerifySignature(types.BindConsensusKeyPayload(ctx.ChainID(), msg.CheckpointPubKey, msg.OriginDomain, msg.Nonce), consSig) {
+		return nil, types.ErrInvalidBinding.Wrap("consensus key signature does not verify")
+	}
 
-	// Create or update metadata with the bound checkpoint key
-	metadata := types.DutyMetadata{
-		CheckpointPubKey:     msg.CheckpointPubKey,
-		CheckpointStorageURI: "", // Will be set separately via SetDutyMetadata
+	// Create or update metadata with the bound checkpoint key, keeping any
+	// storage locations set earlier via SetDutyMetadata. The first default
+	// key takes effect at once; replacing one waits for the rotation delay
+	// like RotateCheckpointKey. A domain binding replaces only that domain's
+	// key.
+	key, err := types.NormalizeCheckpointKey(msg.CheckpointPubKey)
+	if err != nil {
+		return nil, err
+	}
+	// Only a proven key claims the checkpoint address index
+	if err := s.k.claimCheckpointKey(ctx, consAddr, key); err != nil {
+		return nil, err
+	}
+	metadata, _, err := s.k.GetDutyMetadata(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	activationHeight := ctx.BlockHeight()
+	if msg.OriginDomain != 0 {
+		metadata.SetDomainCheckpointKey(msg.OriginDomain, key)
+	} else {
+		params, err := s.k.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		activationHeight = metadata.BindCheckpointKey(key, ctx.BlockHeight(), params.KeyRotationDelay)
 	}
 
-	s.k.SetDutyMetadata(ctx, consAddr, metadata)
+	if err := s.k.SetDutyMetadata(ctx, consAddr, metadata); err != nil {
+		return nil, err
+	}
+	if err := s.k.SetValidatorConsAddr(ctx, valAddr, consAddr); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent("duty_checkpoint_key_bound",
 			sdk.NewAttribute("cons_addr", consAddr.String()),
 			sdk.NewAttribute("val_addr", valAddr.String()),
-			sdk.NewAttribute("checkpoint_pub_key", msg.CheckpointPubKey),
+			sdk.NewAttribute("checkpoint_pub_key", key),
+			sdk.NewAttribute("origin_domain", fmt.Sprintf("%d", msg.OriginDomain)),
 			sdk.NewAttribute("binding_signature", msg.BindingSignature),
+			sdk.NewAttribute("activation_height", fmt.Sprintf("%d", activationHeight)),
 			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
 		),
 	)
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *msgServer) SubmitCheckpointSignature(goCtx context.Context, msg *types.MsgSubmitCheckpointSignature) (*emptypb.Empty, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid valoper")
+	}
+
+	// Only the validator operator may submit signatures for its own checkpoint key
+	_, consAddr, err := s.validatorByOperator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := s.k.AddCheckpointSignature(ctx, consAddr, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("duty_checkpoint_signed",
+			sdk.NewAttribute("cons_addr", consAddr.String()),
+			sdk.NewAttribute("val_addr", valAddr.String()),
+			sdk.NewAttribute("origin_domain", fmt.Sprintf("%d", cp.OriginDomain)),
+			sdk.NewAttribute("index", fmt.Sprintf("%d", cp.Index)),
+			sdk.NewAttribute("root", cp.Root),
+			sdk.NewAttribute("message_id", cp.MessageId),
+			sdk.NewAttribute("signed_power", cp.SignedPower),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *msgServer) SubmitCheckpointEquivocation(goCtx context.Context, msg *types.MsgSubmitCheckpointEquivocation) (*emptypb.Empty, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Anyone may submit evidence; the signatures themselves identify the validator
+	if _, err := s.k.HandleCheckpointEquivocation(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if s.k.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", s.k.GetAuthority(), msg.Authority)
+	}
+
+	if err := s.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("duty_params_updated",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("quorum_num", fmt.Sprintf("%d", msg.Params.QuorumNumerator)),
+			sdk.NewAttribute("quorum_den", fmt.Sprintf("%d", msg.Params.QuorumDenominator)),
+			sdk.NewAttribute("quorum_mode", msg.Params.QuorumMode.String()),
+			sdk.NewAttribute("epoch_length", fmt.Sprintf("%d", msg.Params.EpochLength)),
+			sdk.NewAttribute("snapshot_retention", fmt.Sprintf("%d", msg.Params.SnapshotRetention)),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (s *msgServer) RegisterOriginDomain(goCtx context.Context, msg *types.MsgRegisterOriginDomain) (*types.MsgRegisterOriginDomainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if s.k.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", s.k.GetAuthority(), msg.Authority)
+	}
+
+	if err := s.k.SetOriginDomain(ctx, msg.Domain); err != nil {
+		return nil, err
+	}
+	domain, _, err := s.k.GetOriginDomain(ctx, msg.Domain.DomainId)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("duty_origin_domain_registered",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("domain_id", fmt.Sprintf("%d", domain.DomainId)),
+			sdk.NewAttribute("name", domain.Name),
+			sdk.NewAttribute("mailbox", domain.Mailbox),
+			sdk.NewAttribute("merkle_tree_hook", domain.MerkleTreeHook),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+	return &types.MsgRegisterOriginDomainResponse{}, nil
+}
+
+func (s *msgServer) RemoveOriginDomain(goCtx context.Context, msg *types.MsgRemoveOriginDomain) (*types.MsgRemoveOriginDomainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if s.k.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", s.k.GetAuthority(), msg.Authority)
+	}
+
+	if err := s.k.RemoveOriginDomain(ctx, msg.DomainId); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("duty_origin_domain_removed",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("domain_id", fmt.Sprintf("%d", msg.DomainId)),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+	return &types.MsgRemoveOriginDomainResponse{}, nil
+}
@@ -2,19 +2,79 @@ package keeper
 
 import (
 	context "context"
-	"yourapp/x/duty/types"
+	"sort"
+	"strconv"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
 )
 
 type queryServer struct{ k Keeper }
 
 func NewQueryServer(k Keeper) types.QueryServer { return &queryServer{k: k} }
 
-func (q *queryServer) DutySet(goCtx context.Context, _ *types.QueryDutySetRequest) (*types.QueryDutySetResponse, error) {
+func (q *queryServer) DutySet(goCtx context.Context, req *types.QueryDutySetRequest) (*types.QueryDutySetResponse, error) {
+	if req == nil {
+		req = &types.QueryDutySetRequest{}
+	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	set, params := q.k.GetDutySet(ctx)
-	return &types.QueryDutySetResponse{Validators: set, QuorumNum: params.QuorumNumerator, QuorumDen: params.QuorumDenominator}, nil
+	active, found, err := q.k.GetActiveDutySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		// Nothing committed yet (before the first EndBlock)
+		if active, err = q.k.GetPendingDutySet(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if req.OriginDomain != 0 {
+		if active, err = q.k.dutySetForDomain(ctx, active, req.OriginDomain); err != nil {
+			return nil, err
+		}
+	}
+	validators, err := filterDutyValidators(active.Validators, req)
+	if err != nil {
+		return nil, err
+	}
+	validators, pageRes, err := paginateDutyValidators(validators, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDutySetResponse{
+		Validators:      validators,
+		QuorumNum:       active.QuorumNum,
+		QuorumDen:       active.QuorumDen,
+		Epoch:           active.Epoch,
+		Height:          active.Height,
+		Pagination:      pageRes,
+		QuorumMode:      active.QuorumMode,
+		QuorumThreshold: active.QuorumThreshold().String(),
+	}, nil
+}
+func (q *queryServer) PendingDutySet(goCtx context.Context, _ *types.QueryPendingDutySetRequest) (*types.QueryPendingDutySetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pending, err := q.k.GetPendingDutySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nextEpochHeight, err := q.k.NextEpochHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingDutySetResponse{
+		Validators:      pending.Validators,
+		QuorumNum:       pending.QuorumNum,
+		QuorumDen:       pending.QuorumDen,
+		NextEpochHeight: nextEpochHeight,
+		QuorumMode:      pending.QuorumMode,
+		QuorumThreshold: pending.QuorumThreshold().String(),
+	}, nil
 }
 func (q *queryServer) DutyMetadata(goCtx context.Context, req *types.QueryDutyMetadataRequest) (*types.QueryDutyMetadataResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -22,9 +82,434 @@ func (q *queryServer) DutyMetadata(goCtx context.Context, req *types.QueryDutyMe
 	if err != nil {
 		return nil, err
 	}
-	meta, ok := q.k.GetDutyMetadata(ctx, consAddr)
+	meta, ok, err := q.k.GetDutyMetadata(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
 	if !ok {
 		return &types.QueryDutyMetadataResponse{}, nil
 	}
 	return &types.QueryDutyMetadataResponse{Metadata: &meta}, nil
 }
+
+func (q *queryServer) DutyMetadataByOperator(goCtx context.Context, req *types.QueryDutyMetadataByOperatorRequest) (*types.QueryDutyMetadataByOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValAddr)
+	if err != nil {
+		return nil, err
+	}
+	v, err := q.k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("validator %s: %s", valAddr, err)
+	}
+	consAddr, err := v.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryDutyMetadataByOperatorResponse{ConsAddr: sdk.ConsAddress(consAddr).String()}
+	meta, ok, err := q.k.GetDutyMetadata(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		res.Metadata = &meta
+	}
+	return res, nil
+}
+
+func (q *queryServer) DutyValidator(goCtx context.Context, req *types.QueryDutyValidatorRequest) (*types.QueryDutyValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var (
+		v         stakingtypes.Validator
+		lookupErr error
+	)
+	if valAddr, err := sdk.ValAddressFromBech32(req.Address); err == nil {
+		v, lookupErr = q.k.stakingKeeper.GetValidator(ctx, valAddr)
+	} else if consAddr, err := sdk.ConsAddressFromBech32(req.Address); err == nil {
+		// An address replaced by a consensus key rotation still finds the validator
+		if consAddr, err = q.k.CurrentConsAddress(ctx, consAddr); err != nil {
+			return nil, err
+		}
+		v, lookupErr = q.k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	} else {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("%q is neither a validator operator nor a consensus address", req.Address)
+	}
+	if lookupErr != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("validator %s: %s", req.Address, lookupErr)
+	}
+
+	consAddr, err := v.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryDutyValidatorResponse{
+		ValAddr:     v.GetOperator(),
+		ConsAddr:    sdk.ConsAddress(consAddr).String(),
+		Moniker:     v.GetMoniker(),
+		Status:      validatorDutyStatus(v),
+		VotingPower: strconv.FormatInt(v.GetConsensusPower(q.k.stakingKeeper.PowerReduction(ctx)), 10),
+		Tokens:      v.GetTokens().String(),
+	}
+	meta, ok, err := q.k.GetDutyMetadata(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		res.Metadata = &meta
+	}
+	return res, nil
+}
+
+// validatorDutyStatus names a validator's staking status as reported by the
+// DutyValidator query. Jailing takes precedence, since a jailed validator is
+// also unbonding or unbonded.
+func validatorDutyStatus(v stakingtypes.Validator) string {
+	switch {
+	case v.IsJailed():
+		return "jailed"
+	case v.IsBonded():
+		return "bonded"
+	case v.IsUnbonding():
+		return "unbonding"
+	default:
+		return "unbonded"
+	}
+}
+
+func (q *queryServer) DutyValidatorByCheckpointKey(goCtx context.Context, req *types.QueryDutyValidatorByCheckpointKeyRequest) (*types.QueryDutyValidatorByCheckpointKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := types.CheckpointAddress(req.CheckpointKey)
+	if err != nil {
+		return nil, err
+	}
+	consAddr, ok, err := q.k.GetConsAddrByCheckpointAddress(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &types.QueryDutyValidatorByCheckpointKeyResponse{}, nil
+	}
+	res := &types.QueryDutyValidatorByCheckpointKeyResponse{ConsAddr: consAddr.String()}
+	meta, ok, err := q.k.GetDutyMetadata(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		res.Metadata = &meta
+	}
+	return res, nil
+}
+func (q *queryServer) DutySetAtHeight(goCtx context.Context, req *types.QueryDutySetAtHeightRequest) (*types.QueryDutySetAtHeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	snapshot, ok, err := q.k.GetDutySetAtHeight(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, types.ErrSnapshotNotFound.Wrapf("height %d", req.Height)
+	}
+	return &types.QueryDutySetAtHeightResponse{Snapshot: &snapshot, QuorumThreshold: snapshot.QuorumThreshold().String()}, nil
+}
+func (q *queryServer) DutySetByEpoch(goCtx context.Context, req *types.QueryDutySetByEpochRequest) (*types.QueryDutySetByEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	snapshot, ok, err := q.k.GetDutySetByEpoch(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, types.ErrSnapshotNotFound.Wrapf("epoch %d", req.Epoch)
+	}
+	return &types.QueryDutySetByEpochResponse{Snapshot: &snapshot, QuorumThreshold: snapshot.QuorumThreshold().String()}, nil
+}
+func (q *queryServer) Checkpoint(goCtx context.Context, req *types.QueryCheckpointRequest) (*types.QueryCheckpointResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	checkpoints, err := q.k.GetCheckpointsAtIndex(ctx, req.OriginDomain, req.Index)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*types.Checkpoint, 0, len(checkpoints))
+	for i := range checkpoints {
+		out = append(out, &checkpoints[i])
+	}
+	return &types.QueryCheckpointResponse{Checkpoints: out}, nil
+}
+func (q *queryServer) CheckpointSigningInfo(goCtx context.Context, req *types.QueryCheckpointSigningInfoRequest) (*types.QueryCheckpointSigningInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddr)
+	if err != nil {
+		return nil, err
+	}
+	info, ok, err := q.k.GetCheckpointSigningInfo(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &types.QueryCheckpointSigningInfoResponse{}, nil
+	}
+	return &types.QueryCheckpointSigningInfoResponse{Info: &info}, nil
+}
+
+func (q *queryServer) DutySetHealth(goCtx context.Context, _ *types.QueryDutySetHealthRequest) (*types.QueryDutySetHealthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	health, err := q.k.GetDutySetHealth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDutySetHealthResponse{Health: &health}, nil
+}
+
+func (q *queryServer) OriginDomain(goCtx context.Context, req *types.QueryOriginDomainRequest) (*types.QueryOriginDomainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	domain, ok, err := q.k.GetOriginDomain(ctx, req.DomainId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &types.QueryOriginDomainResponse{}, nil
+	}
+	return &types.QueryOriginDomainResponse{Domain: &domain}, nil
+}
+
+func (q *queryServer) OriginDomains(goCtx context.Context, req *types.QueryOriginDomainsRequest) (*types.QueryOriginDomainsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	domains, pageRes, err := query.CollectionPaginate(ctx, q.k.OriginDomains, req.Pagination,
+		func(_ uint32, domain types.OriginDomain) (*types.OriginDomain, error) {
+			return &domain, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOriginDomainsResponse{Domains: domains, Pagination: pageRes}, nil
+}
+
+// AnnouncedStorageLocations answers like ValidatorAnnounce.getAnnouncedStorageLocations:
+// one list per requested validator with every location it announced, oldest
+// first, empty if it announced nothing.
+func (q *queryServer) AnnouncedStorageLocations(goCtx context.Context, req *types.QueryAnnouncedStorageLocationsRequest) (*types.QueryAnnouncedStorageLocationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := &types.QueryAnnouncedStorageLocationsResponse{
+		StorageLocations: make([]*types.AnnouncedStorageLocations, 0, len(req.Validators)),
+	}
+	for _, validator := range req.Validators {
+		addr, err := types.DecodeHex(validator)
+		if err != nil || len(addr) != 20 {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("validator %q is not a 20-byte hex address", validator)
+		}
+		locations, err := q.k.GetAnnouncedStorageLocations(ctx, req.OriginDomain, addr)
+		if err != nil {
+			return nil, err
+		}
+		res.StorageLocations = append(res.StorageLocations, &types.AnnouncedStorageLocations{Locations: locations})
+	}
+	return res, nil
+}
+
+func (q *queryServer) CheckpointKeys(goCtx context.Context, req *types.QueryCheckpointKeysRequest) (*types.QueryCheckpointKeysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddr)
+	if err != nil {
+		return nil, err
+	}
+	meta, ok, err := q.k.GetDutyMetadata(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &types.QueryCheckpointKeysResponse{}, nil
+	}
+	return &types.QueryCheckpointKeysResponse{
+		Current:  meta.CurrentKey(),
+		Pending:  meta.PendingKey,
+		Previous: meta.PreviousKey,
+	}, nil
+}
+
+func (q *queryServer) DutyNonce(goCtx context.Context, req *types.QueryDutyNonceRequest) (*types.QueryDutyNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddr)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := q.k.GetDutyNonce(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDutyNonceResponse{Nonce: nonce}, nil
+}
+
+func (q *queryServer) ArchivedDutyMetadata(goCtx context.Context, req *types.QueryArchivedDutyMetadataRequest) (*types.QueryArchivedDutyMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddr)
+	if err != nil {
+		return nil, err
+	}
+	tombstone, ok, err := q.k.GetTombstone(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &types.QueryArchivedDutyMetadataResponse{}, nil
+	}
+	return &types.QueryArchivedDutyMetadataResponse{Tombstone: &tombstone}, nil
+}
+
+func (q *queryServer) ArchivedDutyMetadataByCheckpointKey(goCtx context.Context, req *types.QueryArchivedDutyMetadataByCheckpointKeyRequest) (*types.QueryArchivedDutyMetadataByCheckpointKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := types.CheckpointAddress(req.CheckpointKey)
+	if err != nil {
+		return nil, err
+	}
+	tombstones, err := q.k.GetTombstonesByCheckpointAddress(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryArchivedDutyMetadataByCheckpointKeyResponse{}
+	for i := range tombstones {
+		res.Tombstones = append(res.Tombstones, &tombstones[i])
+	}
+	return res, nil
+}
+
+func (q *queryServer) ConsensusKeyMigration(goCtx context.Context, req *types.QueryConsensusKeyMigrationRequest) (*types.QueryConsensusKeyMigrationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddr)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryConsensusKeyMigrationResponse{}
+	if to, found, err := q.k.GetConsensusKeyMigration(ctx, consAddr); err != nil {
+		return nil, err
+	} else if found {
+		res.MigratedTo = &to
+	}
+	if from, found, err := q.k.GetConsensusKeyMigrationTo(ctx, consAddr); err != nil {
+		return nil, err
+	} else if found {
+		res.MigratedFrom = &from
+	}
+	current, err := q.k.CurrentConsAddress(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	res.CurrentConsAddr = current.String()
+	return res, nil
+}
+
+// filterDutyValidators applies the DutySet filters and ordering to a copy of
+// validators.
+func filterDutyValidators(validators []*types.DutyValidator, req *types.QueryDutySetRequest) ([]*types.DutyValidator, error) {
+	if req.OnlyWithMetadata && req.MissingMetadata {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("only_with_metadata and missing_metadata are mutually exclusive")
+	}
+	minPower := math.ZeroInt()
+	if req.MinVotingPower != "" {
+		var ok bool
+		if minPower, ok = math.NewIntFromString(req.MinVotingPower); !ok {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid min_voting_power %q", req.MinVotingPower)
+		}
+	}
+
+	type entry struct {
+		v     *types.DutyValidator
+		power math.Int
+	}
+	entries := make([]entry, 0, len(validators))
+	for _, v := range validators {
+		hasMetadata := v.CheckpointPubKey != ""
+		if (req.OnlyWithMetadata && !hasMetadata) || (req.MissingMetadata && hasMetadata) {
+			continue
+		}
+		power, ok := math.NewIntFromString(v.VotingPower)
+		if !ok {
+			power = math.ZeroInt()
+		}
+		if power.LT(minPower) {
+			continue
+		}
+		entries = append(entries, entry{v: v, power: power})
+	}
+
+	// Ties in power are broken by address so pages are stable
+	var less func(a, b entry) bool
+	switch req.Order {
+	case types.DutySetOrder_DUTY_SET_ORDER_POWER_DESC:
+		less = func(a, b entry) bool {
+			if !a.power.Equal(b.power) {
+				return a.power.GT(b.power)
+			}
+			return a.v.ValConsAddr < b.v.ValConsAddr
+		}
+	case types.DutySetOrder_DUTY_SET_ORDER_POWER_ASC:
+		less = func(a, b entry) bool {
+			if !a.power.Equal(b.power) {
+				return a.power.LT(b.power)
+			}
+			return a.v.ValConsAddr < b.v.ValConsAddr
+		}
+	case types.DutySetOrder_DUTY_SET_ORDER_CONS_ADDR:
+		less = func(a, b entry) bool { return a.v.ValConsAddr < b.v.ValConsAddr }
+	default:
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unknown order %s", req.Order)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return less(entries[i], entries[j]) })
+
+	out := make([]*types.DutyValidator, len(entries))
+	for i, e := range entries {
+		out[i] = e.v
+	}
+	return out, nil
+}
+
+// paginateDutyValidators pages an in-memory duty set with the same semantics
+// as query.Paginate. The key is the consensus address of the first validator
+// of the page.
+func paginateDutyValidators(validators []*types.DutyValidator, pageReq *query.PageRequest) ([]*types.DutyValidator, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, sdkerrors.ErrInvalidRequest.Wrap("either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		reversed := make([]*types.DutyValidator, len(validators))
+		for i, v := range validators {
+			reversed[len(validators)-1-i] = v
+		}
+		validators = reversed
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	total := uint64(len(validators))
+	start := min(pageReq.Offset, total)
+	if len(pageReq.Key) > 0 {
+		start = total
+		for i, v := range validators {
+			if v.ValConsAddr == string(pageReq.Key) {
+				start = uint64(i)
+				break
+			}
+		}
+		if start == total {
+			return nil, nil, sdkerrors.ErrInvalidRequest.Wrapf("unknown pagination key %s", pageReq.Key)
+		}
+	}
+	end := total
+	if limit < total-start {
+		end = start + limit
+	}
+
+	res := &query.PageResponse{}
+	if end < total {
+		res.NextKey = []byte(validators[end].ValConsAddr)
+	}
+	if countTotal {
+		res.Total = total
+	}
+	return validators[start:end], res, nil
+}
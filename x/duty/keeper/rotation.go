@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// ActivatePendingCheckpointKeys promotes every pending checkpoint key whose
+// activation height is reached to the validator's current key.
+func (k Keeper) ActivatePendingCheckpointKeys(ctx sdk.Context) error {
+	var due []sdk.ConsAddress
+	rng := collections.NewPrefixUntilPairRange[int64, sdk.ConsAddress](ctx.BlockHeight())
+	err := k.PendingKeyRotations.Walk(ctx, rng, func(key collections.Pair[int64, sdk.ConsAddress]) (bool, error) {
+		due = append(due, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, consAddr := range due {
+		meta, found, err := k.GetDutyMetadata(ctx, consAddr)
+		if err != nil {
+			return err
+		}
+		if !found || !meta.ActivatePendingKey(ctx.BlockHeight()) {
+			continue
+		}
+		if err := k.SetDutyMetadata(ctx, consAddr, meta); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent("duty_checkpoint_key_activated",
+				sdk.NewAttribute("cons_addr", consAddr.String()),
+				sdk.NewAttribute("checkpoint_pub_key", meta.CheckpointPubKey),
+				sdk.NewAttribute("previous_checkpoint_pub_key", meta.PreviousKey.GetCheckpointPubKey()),
+				sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+			),
+		)
+	}
+	return nil
+}
+
+// verifyCheckpointSigner checks that sigHex over digest was made by a key
+// the duty set member may sign checkpoints of originDomain with: the key
+// recorded in the duty set, unless a rotation has retired it since, or a
+// signing key of the validator's current metadata, which includes the
+// pending key during a rotation.
+func (k Keeper) verifyCheckpointSigner(ctx sdk.Context, consAddr sdk.ConsAddress, originDomain uint32, memberKey string, digest []byte, sigHex string) error {
+	meta, found, err := k.GetDutyMetadata(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+	keys := []string{memberKey}
+	if found {
+		if meta.IsRetiredKey(memberKey, ctx.BlockHeight()) {
+			keys = nil
+		}
+		keys = append(keys, meta.SigningKeys(originDomain)...)
+	}
+
+	var firstErr error
+	for _, key := range keys {
+		err := types.VerifyCheckpointSignature(key, digest, sigHex)
+		if err == nil {
+			return nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
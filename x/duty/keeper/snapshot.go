@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// SetDutySetSnapshot stores a snapshot under its height and indexes it by epoch.
+func (k Keeper) SetDutySetSnapshot(ctx sdk.Context, snapshot types.DutySetSnapshot) error {
+	if err := k.DutySetSnapshots.Set(ctx, uint64(snapshot.Height), snapshot); err != nil {
+		return err
+	}
+	return k.DutySetEpochs.Set(ctx, snapshot.Epoch, uint64(snapshot.Height))
+}
+
+// GetDutySetAtHeight returns the snapshot that was in effect at height, i.e.
+// the latest snapshot taken at or before it.
+func (k Keeper) GetDutySetAtHeight(ctx sdk.Context, height int64) (types.DutySetSnapshot, bool, error) {
+	rng := new(collections.Range[uint64]).EndInclusive(uint64(height)).Descending()
+	return k.firstDutySetSnapshot(ctx, rng)
+}
+
+// GetDutySetByEpoch returns the snapshot committed for epoch.
+func (k Keeper) GetDutySetByEpoch(ctx sdk.Context, epoch uint64) (types.DutySetSnapshot, bool, error) {
+	height, found, err := lookup(k.DutySetEpochs.Get(ctx, epoch))
+	if err != nil || !found {
+		return types.DutySetSnapshot{}, false, err
+	}
+	return lookup(k.DutySetSnapshots.Get(ctx, height))
+}
+
+// GetLatestDutySetSnapshot returns the most recent snapshot, if any.
+func (k Keeper) GetLatestDutySetSnapshot(ctx sdk.Context) (types.DutySetSnapshot, bool, error) {
+	return k.firstDutySetSnapshot(ctx, new(collections.Range[uint64]).Descending())
+}
+
+func (k Keeper) firstDutySetSnapshot(ctx sdk.Context, rng collections.Ranger[uint64]) (types.DutySetSnapshot, bool, error) {
+	iter, err := k.DutySetSnapshots.Iterate(ctx, rng)
+	if err != nil {
+		return types.DutySetSnapshot{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.DutySetSnapshot{}, false, nil
+	}
+	snapshot, err := iter.Value()
+	if err != nil {
+		return types.DutySetSnapshot{}, false, err
+	}
+	return snapshot, true, nil
+}
+
+// GetPendingDutySet returns the duty set derived from the live bonded set. It
+// carries no epoch or height until it is committed at the next epoch boundary.
+func (k Keeper) GetPendingDutySet(ctx sdk.Context) (types.DutySetSnapshot, error) {
+	set, params, err := k.GetDutySet(ctx)
+	if err != nil {
+		return types.DutySetSnapshot{}, err
+	}
+	validators := make([]*types.DutyValidator, 0, len(set))
+	for _, dv := range set {
+		v := &types.DutyValidator{
+			ValConsAddr: dv.ValConsAddr,
+			VotingPower: dv.VotingPower,
+			Tokens:      dv.Tokens,
+		}
+		if dv.Metadata != nil {
+			v.CheckpointPubKey = dv.Metadata.CheckpointPubKey
+			v.CheckpointStorageUri = dv.Metadata.CheckpointStorageUri
+			v.DomainConfigs = dv.Metadata.DomainConfigs
+		}
+		validators = append(validators, v)
+	}
+	types.AssignWeights(validators)
+	return types.DutySetSnapshot{
+		Validators: validators,
+		QuorumNum:  params.QuorumNumerator,
+		QuorumDen:  params.QuorumDenominator,
+		QuorumMode: params.QuorumMode,
+		SetHash:    types.ComputeDutySetHash(validators, params.QuorumNumerator, params.QuorumDenominator, params.QuorumMode),
+	}, nil
+}
+
+// GetActiveDutySet returns the duty set committed for the current epoch.
+func (k Keeper) GetActiveDutySet(ctx sdk.Context) (types.DutySetSnapshot, bool, error) {
+	return k.GetLatestDutySetSnapshot(ctx)
+}
+
+// NextEpochHeight returns the height at which the pending duty set will next
+// be committed.
+func (k Keeper) NextEpochHeight(ctx sdk.Context) (int64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	epochLength := int64(params.EpochLength)
+	return (ctx.BlockHeight()/epochLength + 1) * epochLength, nil
+}
+
+// SnapshotDutySet commits the pending duty set as a new epoch if it differs
+// from the active one, then prunes snapshots beyond the retention window.
+func (k Keeper) SnapshotDutySet(ctx sdk.Context) error {
+	snapshot, err := k.GetPendingDutySet(ctx)
+	if err != nil {
+		return err
+	}
+
+	latest, found, err := k.GetLatestDutySetSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	snapshot.Epoch = 1
+	if found {
+		if bytes.Equal(latest.SetHash, snapshot.SetHash) {
+			return nil
+		}
+		snapshot.Epoch = latest.Epoch + 1
+	}
+	snapshot.Height = ctx.BlockHeight()
+
+	if err := k.SetDutySetSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("duty_set_snapshot",
+			sdk.NewAttribute("epoch", fmt.Sprintf("%d", snapshot.Epoch)),
+			sdk.NewAttribute("set_hash", fmt.Sprintf("%X", snapshot.SetHash)),
+			sdk.NewAttribute("validator_count", fmt.Sprintf("%d", len(snapshot.Validators))),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if epoch := snapshot.Epoch; params.SnapshotRetention > 0 && epoch > params.SnapshotRetention {
+		return k.pruneDutySetSnapshots(ctx, epoch-params.SnapshotRetention)
+	}
+	return nil
+}
+
+// pruneDutySetSnapshots deletes every snapshot with an epoch <= upTo.
+func (k Keeper) pruneDutySetSnapshots(ctx sdk.Context, upTo uint64) error {
+	iter, err := k.DutySetEpochs.Iterate(ctx, new(collections.Range[uint64]).EndInclusive(upTo))
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range kvs {
+		if err := k.DutySetEpochs.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+		if err := k.DutySetSnapshots.Remove(ctx, kv.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// ArchiveDutyMetadata moves a removed validator's metadata into a tombstone
+// and deletes it, releasing its checkpoint keys for other validators. The
+// duty nonce is kept so signatures made for the validator stay unusable.
+// found is false if the validator had no metadata.
+func (k Keeper) ArchiveDutyMetadata(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) (types.DutyMetadataTombstone, bool, error) {
+	meta, found, err := k.GetDutyMetadata(ctx, consAddr)
+	if err != nil || !found {
+		return types.DutyMetadataTombstone{}, false, err
+	}
+	tombstone := types.DutyMetadataTombstone{
+		ValConsAddr:   consAddr.String(),
+		ValAddr:       valAddr.String(),
+		Metadata:      meta,
+		RemovalHeight: ctx.BlockHeight(),
+	}
+	if err := k.SetTombstone(ctx, consAddr, tombstone); err != nil {
+		return types.DutyMetadataTombstone{}, false, err
+	}
+	if meta.PendingKey != nil {
+		if err := k.PendingKeyRotations.Remove(ctx, collections.Join(meta.PendingKey.ActivationHeight, consAddr)); err != nil {
+			return types.DutyMetadataTombstone{}, false, err
+		}
+	}
+	if err := k.DutyMetadata.Remove(ctx, consAddr); err != nil {
+		return types.DutyMetadataTombstone{}, false, err
+	}
+	return tombstone, true, nil
+}
+
+// SetTombstone stores a tombstone and indexes it by the checkpoint address of
+// every key it holds, replacing an earlier tombstone of the validator.
+func (k Keeper) SetTombstone(ctx sdk.Context, consAddr sdk.ConsAddress, tombstone types.DutyMetadataTombstone) error {
+	old, found, err := k.GetTombstone(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+	if found {
+		for _, key := range old.Metadata.CheckpointPubKeys() {
+			addr, _ := types.CheckpointAddress(key)
+			if err := k.TombstoneCheckpointAddresses.Remove(ctx, collections.Join(addr, consAddr)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, key := range tombstone.Metadata.CheckpointPubKeys() {
+		addr, _ := types.CheckpointAddress(key)
+		if err := k.TombstoneCheckpointAddresses.Set(ctx, collections.Join(addr, consAddr)); err != nil {
+			return err
+		}
+	}
+	return k.Tombstones.Set(ctx, consAddr, tombstone)
+}
+
+// GetTombstone returns the archived metadata of a removed validator.
+func (k Keeper) GetTombstone(ctx sdk.Context, consAddr sdk.ConsAddress) (types.DutyMetadataTombstone, bool, error) {
+	return lookup(k.Tombstones.Get(ctx, consAddr))
+}
+
+// GetTombstonesByCheckpointAddress returns the tombstones of every removed
+// validator that held a checkpoint key with the given 20-byte address.
+func (k Keeper) GetTombstonesByCheckpointAddress(ctx sdk.Context, checkpointAddr []byte) ([]types.DutyMetadataTombstone, error) {
+	var tombstones []types.DutyMetadataTombstone
+	rng := collections.NewPrefixedPairRange[[]byte, sdk.ConsAddress](checkpointAddr)
+	err := k.TombstoneCheckpointAddresses.Walk(ctx, rng, func(key collections.Pair[[]byte, sdk.ConsAddress]) (bool, error) {
+		tombstone, err := k.Tombstones.Get(ctx, key.K2())
+		if err != nil {
+			return true, fmt.Errorf("tombstone for %s: %w", key.K2(), err)
+		}
+		tombstones = append(tombstones, tombstone)
+		return false, nil
+	})
+	return tombstones, err
+}
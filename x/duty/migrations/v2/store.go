@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// DutyMetaPrefix is the v1 DutyMetadata prefix; its layout is unchanged in v2.
+var DutyMetaPrefix = []byte{0x01}
+
+// legacyDutyMetadata is the JSON layout DutyMetadata was stored with in v1.
+type legacyDutyMetadata struct {
+	CheckpointPubKey     string `json:"checkpoint_pub_key"`
+	CheckpointStorageURI string `json:"checkpoint_storage_uri"`
+}
+
+// MigrateStore rewrites every DutyMetadata entry from its v1 JSON encoding to
+// protobuf. All entries are decoded before any is written, so a corrupt
+// record aborts the migration without leaving the store half converted.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	keys, metas, err := readLegacyMetadata(kvStore)
+	if err != nil {
+		return err
+	}
+	for i := range keys {
+		bz, err := cdc.Marshal(&metas[i])
+		if err != nil {
+			return err
+		}
+		if err := kvStore.Set(keys[i], bz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readLegacyMetadata(kvStore store.KVStore) ([][]byte, []types.DutyMetadata, error) {
+	iter, err := kvStore.Iterator(DutyMetaPrefix, storetypes.PrefixEndBytes(DutyMetaPrefix))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var (
+		keys  [][]byte
+		metas []types.DutyMetadata
+	)
+	for ; iter.Valid(); iter.Next() {
+		var legacy legacyDutyMetadata
+		if err := json.Unmarshal(iter.Value(), &legacy); err != nil {
+			return nil, nil, fmt.Errorf("decode legacy duty metadata at key %X: %w", iter.Key(), err)
+		}
+		keys = append(keys, append([]byte(nil), iter.Key()...))
+		metas = append(metas, types.DutyMetadata{
+			CheckpointPubKey:     legacy.CheckpointPubKey,
+			CheckpointStorageUri: legacy.CheckpointStorageURI,
+		})
+	}
+	return keys, metas, nil
+}
@@ -0,0 +1,106 @@
+package v3
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/exported"
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// ParamsKey is the key of the params item; it is unchanged from v2.
+var ParamsKey = []byte{0x09}
+
+// Legacy params.Service keys. The service wrote each param as JSON under its
+// bare name in the module store, next to the prefixed collections.
+const (
+	legacyQuorumNumeratorKey                = "QuorumNumerator"
+	legacyQuorumDenominatorKey              = "QuorumDenominator"
+	legacySnapshotRetentionKey              = "SnapshotRetention"
+	legacyEpochLengthKey                    = "EpochLength"
+	legacySignedCheckpointsWindowKey        = "SignedCheckpointsWindow"
+	legacyMinSignedPerWindowKey             = "MinSignedPerWindow"
+	legacySlashFractionMissedCheckpointsKey = "SlashFractionMissedCheckpoints"
+	legacyQuorumModeKey                     = "QuorumMode"
+)
+
+// MigrateStore makes the params item the only source of params. If the item
+// has not been written yet it is built from the defaults, overlaid with the
+// legacy subspace and then with the params.Service keys, which were written
+// by the most recent SetParams. The service keys are deleted either way.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec, legacySubspace exported.Subspace) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	has, err := kvStore.Has(ParamsKey)
+	if err != nil {
+		return err
+	}
+	if !has {
+		params := types.DefaultParams()
+		if legacySubspace != nil {
+			legacySubspace.GetParamSetIfExists(ctx, &params)
+		}
+		if err := readServiceParams(kvStore, &params); err != nil {
+			return err
+		}
+		if err := params.Validate(); err != nil {
+			return fmt.Errorf("invalid legacy params: %w", err)
+		}
+		bz, err := cdc.Marshal(&params)
+		if err != nil {
+			return err
+		}
+		if err := kvStore.Set(ParamsKey, bz); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range []string{
+		legacyQuorumNumeratorKey,
+		legacyQuorumDenominatorKey,
+		legacySnapshotRetentionKey,
+		legacyEpochLengthKey,
+		legacySignedCheckpointsWindowKey,
+		legacyMinSignedPerWindowKey,
+		legacySlashFractionMissedCheckpointsKey,
+		legacyQuorumModeKey,
+	} {
+		if err := kvStore.Delete([]byte(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readServiceParams(kvStore store.KVStore, params *types.Params) error {
+	fields := []struct {
+		key string
+		ptr any
+	}{
+		{legacyQuorumNumeratorKey, &params.QuorumNumerator},
+		{legacyQuorumDenominatorKey, &params.QuorumDenominator},
+		{legacySnapshotRetentionKey, &params.SnapshotRetention},
+		{legacyEpochLengthKey, &params.EpochLength},
+		{legacySignedCheckpointsWindowKey, &params.SignedCheckpointsWindow},
+		{legacyMinSignedPerWindowKey, &params.MinSignedPerWindow},
+		{legacySlashFractionMissedCheckpointsKey, &params.SlashFractionMissedCheckpoints},
+		{legacyQuorumModeKey, &params.QuorumMode},
+	}
+	for _, f := range fields {
+		bz, err := kvStore.Get([]byte(f.key))
+		if err != nil {
+			return err
+		}
+		if bz == nil {
+			continue
+		}
+		if err := json.Unmarshal(bz, f.ptr); err != nil {
+			return fmt.Errorf("decode legacy param %s: %w", f.key, err)
+		}
+	}
+	return nil
+}
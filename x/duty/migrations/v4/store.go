@@ -0,0 +1,42 @@
+package v4
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// ParamsKey is the key of the params item; it is unchanged from v3.
+var ParamsKey = []byte{0x09}
+
+// MigrateStore sets the max_storage_uri_length param, added in v4, to its
+// default. Params written before v4 decode it as zero, which fails
+// validation. Params added by later versions are still unset here, so the
+// params are not validated as a whole.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	bz, err := kvStore.Get(ParamsKey)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return fmt.Errorf("params not found")
+	}
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+	if params.MaxStorageUriLength != 0 {
+		return nil
+	}
+	params.MaxStorageUriLength = types.DefaultMaxStorageURILength
+	if bz, err = cdc.Marshal(&params); err != nil {
+		return err
+	}
+	return kvStore.Set(ParamsKey, bz)
+}
@@ -0,0 +1,43 @@
+package v5
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// ParamsKey is the key of the params item; it is unchanged from v4.
+var ParamsKey = []byte{0x09}
+
+// MigrateStore sets the key_rotation_delay param, added in v5, to its
+// default if it is unset. Params written before v5 decode it as zero, which
+// would activate rotations in the block they are submitted in. Params added
+// by later versions are still unset here, so the params are not validated as
+// a whole.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	bz, err := kvStore.Get(ParamsKey)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return fmt.Errorf("params not found")
+	}
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+	if params.KeyRotationDelay != 0 {
+		return nil
+	}
+	params.KeyRotationDelay = types.DefaultKeyRotationDelay
+	if bz, err = cdc.Marshal(&params); err != nil {
+		return err
+	}
+	return kvStore.Set(ParamsKey, bz)
+}
@@ -0,0 +1,48 @@
+package v7
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TheArticulation/Duty/x/duty/types"
+)
+
+// ParamsKey is the key of the params item; it is unchanged from v6.
+var ParamsKey = []byte{0x09}
+
+// MigrateStore sets the checkpoint_signing_grace_period param, added in v7,
+// to its default if it is unset. Params written before v7 decode it as zero,
+// which records liveness in the block a checkpoint reaches quorum. The
+// default is lowered if snapshot retention would prune a duty set before
+// the grace period ends.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	bz, err := kvStore.Get(ParamsKey)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return fmt.Errorf("params not found")
+	}
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+	if params.CheckpointSigningGracePeriod != 0 {
+		return nil
+	}
+	params.CheckpointSigningGracePeriod = types.DefaultCheckpointSigningGracePeriod
+	if r := params.SnapshotRetention; r > 1 {
+		if limit := (r - 1) * params.EpochLength; params.CheckpointSigningGracePeriod >= limit {
+			params.CheckpointSigningGracePeriod = limit - 1
+		}
+	}
+	if bz, err = cdc.Marshal(&params); err != nil {
+		return err
+	}
+	return kvStore.Set(ParamsKey, bz)
+}
@@ -3,19 +3,26 @@ package duty
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/store"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
-	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/TheArticulation/Duty/x/duty/client"
+	"github.com/TheArticulation/Duty/x/duty/exported"
 	"github.com/TheArticulation/Duty/x/duty/genesis"
 	"github.com/TheArticulation/Duty/x/duty/keeper"
 	"github.com/TheArticulation/Duty/x/duty/modulev1"
@@ -26,13 +33,15 @@ import (
 type ModuleInputs struct {
 	depinject.In
 
-	Codec         codec.Codec
-	StoreService  store.KVStoreService
-	ParamSpace    paramtypes.Subspace
-	StakingKeeper *stakingkeeper.Keeper
-	Logger        log.Logger
-	Config        *modulev1.Module
-	ParamsService types.ParamsService
+	Codec          codec.Codec
+	StoreService   store.KVStoreService
+	StakingKeeper  *stakingkeeper.Keeper
+	SlashingKeeper *slashingkeeper.Keeper
+	Logger         log.Logger
+	Config         *modulev1.Module
+
+	// LegacySubspace is only read by the v3 migration
+	LegacySubspace exported.Subspace `optional:"true"`
 }
 
 // ModuleOutputs defines the outputs for the duty module
@@ -53,23 +62,26 @@ type HooksInputs struct {
 
 // ProvideModule provides the duty module with dependency injection
 func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
-	// Set default parameters from config if provided
-	if in.Config != nil {
-		// Set the default parameters in the param space
-		in.ParamSpace.Set(context.Background(), types.KeyQuorumNumerator, in.Config.QuorumNum)
-		in.ParamSpace.Set(context.Background(), types.KeyQuorumDenominator, in.Config.QuorumDen)
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config != nil && in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
 	k := keeper.NewKeeper(
 		in.Codec,
 		in.StoreService,
-		in.ParamSpace,
 		in.StakingKeeper,
+		in.SlashingKeeper,
 		in.Logger,
-		in.ParamsService,
+		authority.String(),
 	)
 
-	appModule := NewAppModule(k)
+	legacySubspace := in.LegacySubspace
+	if ss, ok := legacySubspace.(paramtypes.Subspace); ok && !ss.HasKeyTable() {
+		legacySubspace = ss.WithKeyTable(types.ParamKeyTable())
+	}
+	appModule := NewAppModule(k, legacySubspace)
 
 	return ModuleOutputs{
 		Keeper:    k,
@@ -87,14 +99,31 @@ func RegisterHooks(in HooksInputs) error {
 type AppModuleBasic struct{}
 
 func (AppModuleBasic) Name() string { return types.ModuleName }
-func (AppModuleBasic) RegisterInterfaces(reg codec.Types) {
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+func (AppModuleBasic) RegisterInterfaces(reg codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(reg)
 }
-func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(genesis.DefaultGenesis())
+
+// RegisterGRPCGatewayRoutes is a no-op; the module has no gRPC gateway.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(sdkclient.Context, *gwruntime.ServeMux) {}
+
+// The genesis state is a plain struct, so it is encoded with encoding/json
+// rather than the proto JSON codec.
+func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
+	bz, err := json.Marshal(genesis.DefaultGenesis())
+	if err != nil {
+		panic(err)
+	}
+	return bz
 }
-func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ module.ClientTxEncodingConfig, bz json.RawMessage) error {
-	return nil
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ sdkclient.TxEncodingConfig, bz json.RawMessage) error {
+	var gs genesis.GenesisState
+	if err := json.Unmarshal(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	return gs.Validate()
 }
 
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
@@ -105,24 +134,74 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return client.GetQueryCmd()
 }
 
+// ConsensusVersion is bumped whenever the module's state layout changes.
+const ConsensusVersion = 7
+
 type AppModule struct {
 	AppModuleBasic
 	Keeper keeper.Keeper
+
+	legacySubspace exported.Subspace
 }
 
-func NewAppModule(k keeper.Keeper) AppModule { return AppModule{Keeper: k} }
+func NewAppModule(k keeper.Keeper, legacySubspace exported.Subspace) AppModule {
+	return AppModule{Keeper: k, legacySubspace: legacySubspace}
+}
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.Keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.Keeper))
+
+	m := keeper.NewMigrator(am.Keeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration 1->2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration 2->3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration 3->4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration 4->5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration 5->6: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration 6->7: %v", types.ModuleName, err))
+	}
 }
 
-func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AppModule) IsOnePerModuleType() {}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (AppModule) IsAppModule() {}
+
+func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, data json.RawMessage) {
 	var gs genesis.GenesisState
-	cdc.MustUnmarshalJSON(data, &gs)
-	genesis.InitGenesis(ctx, am.Keeper, &gs)
-	return nil
+	if err := json.Unmarshal(data, &gs); err != nil {
+		panic(fmt.Sprintf("failed to unmarshal %s genesis state: %v", types.ModuleName, err))
+	}
+	if err := genesis.InitGenesis(ctx, am.Keeper, &gs); err != nil {
+		panic(err)
+	}
+}
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	return am.Keeper.EndBlocker(sdk.UnwrapSDKContext(goCtx))
 }
-func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(genesis.ExportGenesis(ctx, am.Keeper))
+
+func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	gs, err := genesis.ExportGenesis(ctx, am.Keeper)
+	if err != nil {
+		panic(err)
+	}
+	bz, err := json.Marshal(gs)
+	if err != nil {
+		panic(err)
+	}
+	return bz
 }
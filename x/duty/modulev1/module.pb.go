@@ -1,8 +1,8 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
-// 	protoc        v3.21.12
-// source: proto/duty/module/v1/module.proto
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: duty/module/v1/module.proto
 
 package modulev1
 
@@ -26,16 +26,25 @@ type Module struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// quorum_num defines the numerator of the quorum fraction
+	// quorum_num is ignored; the quorum comes from genesis params and
+	// MsgUpdateParams.
+	//
+	// Deprecated: Marked as deprecated in duty/module/v1/module.proto.
 	QuorumNum uint32 `protobuf:"varint,1,opt,name=quorum_num,json=quorumNum,proto3" json:"quorum_num,omitempty"`
-	// quorum_den defines the denominator of the quorum fraction
+	// quorum_den is ignored; the quorum comes from genesis params and
+	// MsgUpdateParams.
+	//
+	// Deprecated: Marked as deprecated in duty/module/v1/module.proto.
 	QuorumDen uint32 `protobuf:"varint,2,opt,name=quorum_den,json=quorumDen,proto3" json:"quorum_den,omitempty"`
+	// authority defines the custom module authority. If not set, defaults to
+	// the governance module.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
 func (x *Module) Reset() {
 	*x = Module{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_duty_module_v1_module_proto_msgTypes[0]
+		mi := &file_duty_module_v1_module_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -48,7 +57,7 @@ func (x *Module) String() string {
 func (*Module) ProtoMessage() {}
 
 func (x *Module) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_duty_module_v1_module_proto_msgTypes[0]
+	mi := &file_duty_module_v1_module_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,9 +70,10 @@ func (x *Module) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Module.ProtoReflect.Descriptor instead.
 func (*Module) Descriptor() ([]byte, []int) {
-	return file_proto_duty_module_v1_module_proto_rawDescGZIP(), []int{0}
+	return file_duty_module_v1_module_proto_rawDescGZIP(), []int{0}
 }
 
+// Deprecated: Marked as deprecated in duty/module/v1/module.proto.
 func (x *Module) GetQuorumNum() uint32 {
 	if x != nil {
 		return x.QuorumNum
@@ -71,6 +81,7 @@ func (x *Module) GetQuorumNum() uint32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in duty/module/v1/module.proto.
 func (x *Module) GetQuorumDen() uint32 {
 	if x != nil {
 		return x.QuorumDen
@@ -78,17 +89,26 @@ func (x *Module) GetQuorumDen() uint32 {
 	return 0
 }
 
-var File_proto_duty_module_v1_module_proto protoreflect.FileDescriptor
-
-var file_proto_duty_module_v1_module_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x75, 0x74, 0x79, 0x2f, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x64, 0x75, 0x74, 0x79, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x22, 0x4a, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a,
-	0x0a, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x09, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x0a,
-	0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x09, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x44, 0x65, 0x6e, 0x42, 0x2a, 0x5a, 0x28, 0x67,
+func (x *Module) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+var File_duty_module_v1_module_proto protoreflect.FileDescriptor
+
+var file_duty_module_v1_module_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x64, 0x75, 0x74, 0x79, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x64,
+	0x75, 0x74, 0x79, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x6c, 0x0a,
+	0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x09, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x21, 0x0a, 0x0a, 0x71, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x09, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x44, 0x65, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x31, 0x5a, 0x2f, 0x67,
 	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x41, 0x72, 0x74,
 	0x69, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x44, 0x75, 0x74, 0x79, 0x2f, 0x78,
 	0x2f, 0x64, 0x75, 0x74, 0x79, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x76, 0x31, 0x62, 0x06,
@@ -96,22 +116,22 @@ var file_proto_duty_module_v1_module_proto_rawDesc = []byte{
 }
 
 var (
-	file_proto_duty_module_v1_module_proto_rawDescOnce sync.Once
-	file_proto_duty_module_v1_module_proto_rawDescData = file_proto_duty_module_v1_module_proto_rawDesc
+	file_duty_module_v1_module_proto_rawDescOnce sync.Once
+	file_duty_module_v1_module_proto_rawDescData = file_duty_module_v1_module_proto_rawDesc
 )
 
-func file_proto_duty_module_v1_module_proto_rawDescGZIP() []byte {
-	file_proto_duty_module_v1_module_proto_rawDescOnce.Do(func() {
-		file_proto_duty_module_v1_module_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_duty_module_v1_module_proto_rawDescData)
+func file_duty_module_v1_module_proto_rawDescGZIP() []byte {
+	file_duty_module_v1_module_proto_rawDescOnce.Do(func() {
+		file_duty_module_v1_module_proto_rawDescData = protoimpl.X.CompressGZIP(file_duty_module_v1_module_proto_rawDescData)
 	})
-	return file_proto_duty_module_v1_module_proto_rawDescData
+	return file_duty_module_v1_module_proto_rawDescData
 }
 
-var file_proto_duty_module_v1_module_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_proto_duty_module_v1_module_proto_goTypes = []interface{}{
+var file_duty_module_v1_module_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_duty_module_v1_module_proto_goTypes = []interface{}{
 	(*Module)(nil), // 0: duty.module.v1.Module
 }
-var file_proto_duty_module_v1_module_proto_depIdxs = []int32{
+var file_duty_module_v1_module_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
 	0, // [0:0] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
@@ -119,13 +139,13 @@ var file_proto_duty_module_v1_module_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for field type_name
 }
 
-func init() { file_proto_duty_module_v1_module_proto_init() }
-func file_proto_duty_module_v1_module_proto_init() {
-	if File_proto_duty_module_v1_module_proto != nil {
+func init() { file_duty_module_v1_module_proto_init() }
+func file_duty_module_v1_module_proto_init() {
+	if File_duty_module_v1_module_proto != nil {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_proto_duty_module_v1_module_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_duty_module_v1_module_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Module); i {
 			case 0:
 				return &v.state
@@ -142,18 +162,18 @@ func file_proto_duty_module_v1_module_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_proto_duty_module_v1_module_proto_rawDesc,
+			RawDescriptor: file_duty_module_v1_module_proto_rawDesc,
 			NumEnums:      0,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
-		GoTypes:           file_proto_duty_module_v1_module_proto_goTypes,
-		DependencyIndexes: file_proto_duty_module_v1_module_proto_depIdxs,
-		MessageInfos:      file_proto_duty_module_v1_module_proto_msgTypes,
+		GoTypes:           file_duty_module_v1_module_proto_goTypes,
+		DependencyIndexes: file_duty_module_v1_module_proto_depIdxs,
+		MessageInfos:      file_duty_module_v1_module_proto_msgTypes,
 	}.Build()
-	File_proto_duty_module_v1_module_proto = out.File
-	file_proto_duty_module_v1_module_proto_rawDesc = nil
-	file_proto_duty_module_v1_module_proto_goTypes = nil
-	file_proto_duty_module_v1_module_proto_depIdxs = nil
+	File_duty_module_v1_module_proto = out.File
+	file_duty_module_v1_module_proto_rawDesc = nil
+	file_duty_module_v1_module_proto_goTypes = nil
+	file_duty_module_v1_module_proto_depIdxs = nil
 }
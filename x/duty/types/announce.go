@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// AnnouncementDomainHash mirrors Hyperlane's ValidatorAnnounce._domainHash:
+// keccak256(abi.encodePacked(localDomain, mailbox, "HYPERLANE_ANNOUNCEMENT")).
+func AnnouncementDomainHash(originDomain uint32, mailbox []byte) []byte {
+	return Keccak256(binary.BigEndian.AppendUint32(nil, originDomain), mailbox, []byte("HYPERLANE_ANNOUNCEMENT"))
+}
+
+// AnnouncementDigest mirrors ValidatorAnnounce.getAnnouncementDigest before
+// the EIP-191 prefix is applied:
+// keccak256(abi.encodePacked(domainHash, storageLocation)).
+func AnnouncementDigest(originDomain uint32, mailbox []byte, storageLocation string) []byte {
+	return Keccak256(AnnouncementDomainHash(originDomain, mailbox), []byte(storageLocation))
+}
+
+// Verify checks that the announcement is signed by pubKey for storageLocation
+// on the registered domain and returns the form kept in DutyMetadata.
+func (a ValidatorAnnouncement) Verify(domain OriginDomain, pubKey, storageLocation string) (StorageAnnouncement, error) {
+	if pubKey == "" || storageLocation == "" {
+		return StorageAnnouncement{}, ErrInvalidAnnouncement.Wrapf("no checkpoint key or storage location for domain %d", a.OriginDomain)
+	}
+	mailbox, err := DecodeBytes32("mailbox", domain.Mailbox)
+	if err != nil {
+		return StorageAnnouncement{}, ErrInvalidAnnouncement.Wrapf("domain %d: %s", a.OriginDomain, err)
+	}
+	digest := AnnouncementDigest(a.OriginDomain, mailbox, storageLocation)
+	if err := VerifyCheckpointSignature(pubKey, digest, a.Signature); err != nil {
+		return StorageAnnouncement{}, ErrInvalidAnnouncement.Wrapf("domain %d: %s", a.OriginDomain, err)
+	}
+	addr, _ := CheckpointAddress(pubKey)
+	return StorageAnnouncement{
+		OriginDomain:    a.OriginDomain,
+		Validator:       "0x" + hex.EncodeToString(addr),
+		StorageLocation: storageLocation,
+		Signature:       NormalizeHex(a.Signature),
+	}, nil
+}
+
+// ValidateAnnouncements checks that announcements name a domain once each and
+// carry a 65-byte signature.
+func ValidateAnnouncements(announcements []*ValidatorAnnouncement) error {
+	seen := make(map[uint32]bool, len(announcements))
+	for _, a := range announcements {
+		if a == nil || a.OriginDomain == 0 {
+			return ErrInvalidAnnouncement.Wrap("announcement without origin domain")
+		}
+		if seen[a.OriginDomain] {
+			return ErrInvalidAnnouncement.Wrapf("duplicate announcement for domain %d", a.OriginDomain)
+		}
+		seen[a.OriginDomain] = true
+		if err := validateEthSignature(a.Signature); err != nil {
+			return ErrInvalidAnnouncement.Wrapf("domain %d: signature %s", a.OriginDomain, err)
+		}
+	}
+	return nil
+}
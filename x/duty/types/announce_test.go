@@ -0,0 +1,45 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorAnnouncement_Verify(t *testing.T) {
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	pubKey := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	addr := "0x" + hex.EncodeToString(EthAddress(priv.PubKey()))
+
+	domain := OriginDomain{DomainId: 1, Mailbox: "0x" + strings.Repeat("ab", 32)}
+	mailbox, err := DecodeBytes32("mailbox", domain.Mailbox)
+	require.NoError(t, err)
+	location := "s3://bucket/ethereum"
+
+	a := ValidatorAnnouncement{
+		OriginDomain: 1,
+		Signature:    signEthMessage(t, priv, AnnouncementDigest(1, mailbox, location)),
+	}
+	stored, err := a.Verify(domain, pubKey, location)
+	require.NoError(t, err)
+	assert.Equal(t, addr, stored.Validator)
+	assert.Equal(t, location, stored.StorageLocation)
+
+	// The signature commits to the location and the mailbox
+	_, err = a.Verify(domain, pubKey, "s3://bucket/other")
+	assert.ErrorIs(t, err, ErrInvalidAnnouncement)
+	otherMailbox := domain
+	otherMailbox.Mailbox = "0x" + strings.Repeat("cd", 32)
+	_, err = a.Verify(otherMailbox, pubKey, location)
+	assert.ErrorIs(t, err, ErrInvalidAnnouncement)
+
+	// Duplicate domains and malformed signatures are rejected up front
+	assert.NoError(t, ValidateAnnouncements([]*ValidatorAnnouncement{&a}))
+	assert.ErrorIs(t, ValidateAnnouncements([]*ValidatorAnnouncement{&a, &a}), ErrInvalidAnnouncement)
+	assert.ErrorIs(t, ValidateAnnouncements([]*ValidatorAnnouncement{{OriginDomain: 1, Signature: "0x1234"}}), ErrInvalidAnnouncement)
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+)
+
+// HyperlaneDomainHash mirrors Hyperlane's CheckpointLib.domainHash:
+// keccak256(abi.encodePacked(origin, merkleTreeHook, "HYPERLANE")).
+func HyperlaneDomainHash(originDomain uint32, merkleTreeHook []byte) []byte {
+	return Keccak256(binary.BigEndian.AppendUint32(nil, originDomain), merkleTreeHook, []byte("HYPERLANE"))
+}
+
+// CheckpointDigest mirrors Hyperlane's CheckpointLib.digest before the EIP-191
+// prefix is applied: keccak256(abi.encodePacked(domainHash, root, index, messageId)).
+func CheckpointDigest(originDomain uint32, merkleTreeHook, root []byte, index uint32, messageID []byte) []byte {
+	return Keccak256(HyperlaneDomainHash(originDomain, merkleTreeHook), root, binary.BigEndian.AppendUint32(nil, index), messageID)
+}
+
+// DecodeBytes32 decodes a hex encoded 32-byte value.
+func DecodeBytes32(name, s string) ([]byte, error) {
+	bz, err := DecodeHex(s)
+	if err != nil {
+		return nil, ErrInvalidCheckpoint.Wrapf("%s not hex: %s", name, err)
+	}
+	if len(bz) != 32 {
+		return nil, ErrInvalidCheckpoint.Wrapf("%s must be 32 bytes, got %d", name, len(bz))
+	}
+	return bz, nil
+}
+
+// Digest returns the Hyperlane checkpoint digest the message signs.
+func (m *MsgSubmitCheckpointSignature) Digest() ([]byte, error) {
+	return decodeCheckpointDigest(m.OriginDomain, m.MerkleTreeHook, m.Root, m.Index, m.MessageId)
+}
+
+// Digest returns the Hyperlane checkpoint digest the signature is over.
+func (c *SignedCheckpoint) Digest() ([]byte, error) {
+	return decodeCheckpointDigest(c.OriginDomain, c.MerkleTreeHook, c.Root, c.Index, c.MessageId)
+}
+
+// RecoverSigner returns the Ethereum address that signed the checkpoint.
+func (c *SignedCheckpoint) RecoverSigner() ([]byte, error) {
+	digest, err := c.Digest()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := DecodeHex(c.Signature)
+	if err != nil {
+		return nil, ErrInvalidCheckpoint.Wrapf("signature not hex: %s", err)
+	}
+	signer, err := RecoverEthAddress(EthSignedMessageHash(digest), sig)
+	if err != nil {
+		return nil, ErrInvalidCheckpoint.Wrap(err.Error())
+	}
+	return signer, nil
+}
+
+func decodeCheckpointDigest(originDomain uint32, merkleTreeHook, root string, index uint32, messageID string) ([]byte, error) {
+	hookBz, err := DecodeBytes32("merkle tree hook", merkleTreeHook)
+	if err != nil {
+		return nil, err
+	}
+	rootBz, err := DecodeBytes32("root", root)
+	if err != nil {
+		return nil, err
+	}
+	messageIDBz, err := DecodeBytes32("message id", messageID)
+	if err != nil {
+		return nil, err
+	}
+	return CheckpointDigest(originDomain, hookBz, rootBz, index, messageIDBz), nil
+}
+
+// QuorumPowerThreshold returns the least power that reaches quorum out of
+// total, i.e. ceil(total * quorumNum / quorumDen).
+func QuorumPowerThreshold(total math.Int, quorumNum, quorumDen uint32) math.Int {
+	if quorumDen == 0 {
+		return math.ZeroInt()
+	}
+	den := int64(quorumDen)
+	return total.MulRaw(int64(quorumNum)).AddRaw(den - 1).QuoRaw(den)
+}
+
+// QuorumCountThreshold returns the least number of signers out of n that
+// reaches quorum, i.e. ceil(n * quorumNum / quorumDen). This is the threshold
+// of a Hyperlane static multisig ISM over the same n validators.
+func QuorumCountThreshold(n, quorumNum, quorumDen uint32) uint32 {
+	if quorumDen == 0 {
+		return 0
+	}
+	den := uint64(quorumDen)
+	return uint32((uint64(n)*uint64(quorumNum) + den - 1) / den)
+}
+
+// QuorumReached reports whether signed/total >= quorumNum/quorumDen.
+func QuorumReached(signed, total math.Int, quorumNum, quorumDen uint32) bool {
+	if !total.IsPositive() {
+		return false
+	}
+	return signed.MulRaw(int64(quorumDen)).GTE(total.MulRaw(int64(quorumNum)))
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuorumReached(t *testing.T) {
+	total := math.NewInt(300)
+
+	assert.False(t, QuorumReached(math.NewInt(199), total, 2, 3))
+	assert.True(t, QuorumReached(math.NewInt(200), total, 2, 3))
+	assert.True(t, QuorumReached(total, total, 2, 3))
+
+	// An empty set can never reach quorum
+	assert.False(t, QuorumReached(math.ZeroInt(), math.ZeroInt(), 2, 3))
+}
+
+func TestQuorumPowerThreshold(t *testing.T) {
+	assert.Equal(t, math.NewInt(200), QuorumPowerThreshold(math.NewInt(300), 2, 3))
+	// Rounds up, and is the least power QuorumReached accepts
+	threshold := QuorumPowerThreshold(math.NewInt(100), 2, 3)
+	assert.Equal(t, math.NewInt(67), threshold)
+	assert.True(t, QuorumReached(threshold, math.NewInt(100), 2, 3))
+	assert.False(t, QuorumReached(threshold.SubRaw(1), math.NewInt(100), 2, 3))
+}
+
+func TestQuorumCountThreshold(t *testing.T) {
+	// A single validator always has to sign
+	assert.Equal(t, uint32(1), QuorumCountThreshold(1, 2, 3))
+	assert.Equal(t, uint32(1), QuorumCountThreshold(1, 1, 100))
+	// 2/3 of 4 is 2.67, rounded up
+	assert.Equal(t, uint32(3), QuorumCountThreshold(4, 2, 3))
+	assert.Equal(t, uint32(2), QuorumCountThreshold(3, 2, 3))
+	assert.Equal(t, uint32(2), QuorumCountThreshold(4, 1, 2))
+	assert.Equal(t, uint32(5), QuorumCountThreshold(5, 1, 1))
+	// No validators, no threshold
+	assert.Equal(t, uint32(0), QuorumCountThreshold(0, 2, 3))
+	assert.Equal(t, uint32(0), QuorumCountThreshold(4, 2, 0))
+
+	// The threshold is the least count QuorumReached accepts
+	for n := uint32(1); n <= 10; n++ {
+		threshold := QuorumCountThreshold(n, 2, 3)
+		total := math.NewInt(int64(n))
+		assert.True(t, QuorumReached(math.NewInt(int64(threshold)), total, 2, 3), "n=%d", n)
+		assert.False(t, QuorumReached(math.NewInt(int64(threshold-1)), total, 2, 3), "n=%d", n)
+	}
+}
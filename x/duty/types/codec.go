@@ -13,6 +13,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetDutyMetadata{}, "duty/SetDutyMetadata", nil)
 	cdc.RegisterConcrete(&MsgRotateCheckpointKey{}, "duty/RotateCheckpointKey", nil)
 	cdc.RegisterConcrete(&MsgBindCheckpointKey{}, "duty/BindCheckpointKey", nil)
+	cdc.RegisterConcrete(&MsgSubmitCheckpointSignature{}, "duty/SubmitCheckpointSignature", nil)
+	cdc.RegisterConcrete(&MsgSubmitCheckpointEquivocation{}, "duty/SubmitCheckpointEquivocation", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "duty/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRegisterOriginDomain{}, "duty/RegisterOriginDomain", nil)
+	cdc.RegisterConcrete(&MsgRemoveOriginDomain{}, "duty/RemoveOriginDomain", nil)
 }
 
 // RegisterInterfaces registers the x/duty interfaces types with the interface registry
@@ -21,6 +26,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSetDutyMetadata{},
 		&MsgRotateCheckpointKey{},
 		&MsgBindCheckpointKey{},
+		&MsgSubmitCheckpointSignature{},
+		&MsgSubmitCheckpointEquivocation{},
+		&MsgUpdateParams{},
+		&MsgRegisterOriginDomain{},
+		&MsgRemoveOriginDomain{},
 	)
 }
 
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Validate checks the domain ID, name, addresses and quorum override.
+func (d OriginDomain) Validate() error {
+	if d.DomainId == 0 {
+		return ErrInvalidOriginDomain.Wrap("domain id cannot be zero")
+	}
+	if d.Name == "" {
+		return ErrInvalidOriginDomain.Wrapf("domain %d: missing name", d.DomainId)
+	}
+	if _, err := DecodeBytes32("mailbox", d.Mailbox); err != nil {
+		return ErrInvalidOriginDomain.Wrapf("domain %d: %s", d.DomainId, err)
+	}
+	if _, err := DecodeBytes32("merkle tree hook", d.MerkleTreeHook); err != nil {
+		return ErrInvalidOriginDomain.Wrapf("domain %d: %s", d.DomainId, err)
+	}
+	if d.Quorum != nil {
+		if err := d.Quorum.Validate(); err != nil {
+			return ErrInvalidOriginDomain.Wrapf("domain %d: %s", d.DomainId, err)
+		}
+	}
+	return nil
+}
+
+// Validate applies the same rules as the module quorum params.
+func (q QuorumOverride) Validate() error {
+	if q.QuorumNum == 0 || q.QuorumDen == 0 || q.QuorumNum > q.QuorumDen {
+		return fmt.Errorf("invalid quorum %d/%d", q.QuorumNum, q.QuorumDen)
+	}
+	return validateQuorumMode(q.QuorumMode)
+}
+
+// Normalize lower-cases the domain addresses so they compare byte for byte.
+func (d *OriginDomain) Normalize() {
+	d.Mailbox = NormalizeHex(d.Mailbox)
+	d.MerkleTreeHook = NormalizeHex(d.MerkleTreeHook)
+}
+
+// ValidateDomainConfigs checks that every per-domain override names a
+// domain once and sets a key or a storage URI, and that keys parse with a
+// valid checksum.
+func (m DutyMetadata) ValidateDomainConfigs() error {
+	seen := make(map[uint32]bool, len(m.DomainConfigs))
+	for _, c := range m.DomainConfigs {
+		if c == nil || c.OriginDomain == 0 {
+			return ErrInvalidOriginDomain.Wrap("domain config without origin domain")
+		}
+		if seen[c.OriginDomain] {
+			return ErrInvalidOriginDomain.Wrapf("duplicate domain config for %d", c.OriginDomain)
+		}
+		seen[c.OriginDomain] = true
+		if c.CheckpointPubKey == "" && c.CheckpointStorageUri == "" {
+			return ErrInvalidOriginDomain.Wrapf("domain config for %d overrides nothing", c.OriginDomain)
+		}
+		if c.CheckpointPubKey != "" {
+			if _, err := NormalizeCheckpointKey(c.CheckpointPubKey); err != nil {
+				return fmt.Errorf("domain %d: %w", c.OriginDomain, err)
+			}
+		}
+	}
+	return nil
+}
+
+// SetDomainCheckpointKey sets the per-domain key override for originDomain,
+// keeping the domain's storage URI override, if any.
+func (m *DutyMetadata) SetDomainCheckpointKey(originDomain uint32, key string) {
+	for _, c := range m.DomainConfigs {
+		if c != nil && c.OriginDomain == originDomain {
+			c.CheckpointPubKey = key
+			return
+		}
+	}
+	m.DomainConfigs = append(m.DomainConfigs, &DomainCheckpointConfig{OriginDomain: originDomain, CheckpointPubKey: key})
+}
+
+// CheckpointConfigFor returns the checkpoint key and storage URI the
+// validator uses for originDomain.
+func (m DutyMetadata) CheckpointConfigFor(originDomain uint32) (pubKey, storageURI string) {
+	return resolveDomainConfig(m.CheckpointPubKey, m.CheckpointStorageUri, m.DomainConfigs, originDomain)
+}
+
+// CheckpointPubKeys returns the validator's checkpoint keys that parse, the
+// default key first, with at most one key per checkpoint address. The
+// pending and previous default keys are included so no other validator can
+// register them while they may still sign.
+func (m DutyMetadata) CheckpointPubKeys() []string {
+	var (
+		keys []string
+		seen = make(map[string]bool, 3+len(m.DomainConfigs))
+	)
+	add := func(key string) {
+		addr, err := CheckpointAddress(key)
+		if err != nil || seen[string(addr)] {
+			return
+		}
+		seen[string(addr)] = true
+		keys = append(keys, key)
+	}
+	add(m.CheckpointPubKey)
+	for _, c := range m.DomainConfigs {
+		if c != nil {
+			add(c.CheckpointPubKey)
+		}
+	}
+	if m.PendingKey != nil {
+		add(m.PendingKey.CheckpointPubKey)
+	}
+	if m.PreviousKey != nil {
+		add(m.PreviousKey.CheckpointPubKey)
+	}
+	return keys
+}
+
+// CheckpointConfigFor returns the checkpoint key and storage URI the duty
+// validator uses for originDomain.
+func (v *DutyValidator) CheckpointConfigFor(originDomain uint32) (pubKey, storageURI string) {
+	return resolveDomainConfig(v.CheckpointPubKey, v.CheckpointStorageUri, v.DomainConfigs, originDomain)
+}
+
+func resolveDomainConfig(pubKey, storageURI string, configs []*DomainCheckpointConfig, originDomain uint32) (string, string) {
+	for _, c := range configs {
+		if c == nil || c.OriginDomain != originDomain {
+			continue
+		}
+		if c.CheckpointPubKey != "" {
+			pubKey = c.CheckpointPubKey
+		}
+		if c.CheckpointStorageUri != "" {
+			storageURI = c.CheckpointStorageUri
+		}
+		break
+	}
+	return pubKey, storageURI
+}
+
+// ForDomain returns a copy of the snapshot as seen by originDomain: every
+// validator carries its key and storage URI for the domain, and the quorum
+// is replaced by the domain's override, if any. domain may be nil for an
+// unregistered domain.
+func (s DutySetSnapshot) ForDomain(originDomain uint32, domain *OriginDomain) DutySetSnapshot {
+	out := s
+	out.Validators = make([]*DutyValidator, len(s.Validators))
+	for i, v := range s.Validators {
+		resolved := *v
+		resolved.CheckpointPubKey, resolved.CheckpointStorageUri = v.CheckpointConfigFor(originDomain)
+		out.Validators[i] = &resolved
+	}
+	if domain != nil && domain.Quorum != nil {
+		out.QuorumNum = domain.Quorum.QuorumNum
+		out.QuorumDen = domain.Quorum.QuorumDen
+		out.QuorumMode = domain.Quorum.QuorumMode
+	}
+	return out
+}
@@ -0,0 +1,1551 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: duty/v1/domain.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OriginDomain is a Hyperlane origin chain registered by governance.
+type OriginDomain struct {
+	// domain_id is the Hyperlane domain ID of the chain
+	DomainId uint32 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	// name is a human readable chain name, e.g. "ethereum"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// mailbox is the origin Mailbox address (bytes32, hex)
+	Mailbox string `protobuf:"bytes,3,opt,name=mailbox,proto3" json:"mailbox,omitempty"`
+	// merkle_tree_hook is the origin merkle tree hook address (bytes32, hex).
+	// Checkpoints submitted for the domain must commit to this hook.
+	MerkleTreeHook string `protobuf:"bytes,4,opt,name=merkle_tree_hook,json=merkleTreeHook,proto3" json:"merkle_tree_hook,omitempty"`
+	// quorum replaces the module quorum params for checkpoints of this domain;
+	// unset uses the params.
+	Quorum *QuorumOverride `protobuf:"bytes,5,opt,name=quorum,proto3" json:"quorum,omitempty"`
+}
+
+func (m *OriginDomain) Reset()         { *m = OriginDomain{} }
+func (m *OriginDomain) String() string { return proto.CompactTextString(m) }
+func (*OriginDomain) ProtoMessage()    {}
+func (*OriginDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d49cce6520b4f28f, []int{0}
+}
+func (m *OriginDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OriginDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OriginDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OriginDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OriginDomain.Merge(m, src)
+}
+func (m *OriginDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *OriginDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_OriginDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OriginDomain proto.InternalMessageInfo
+
+func (m *OriginDomain) GetDomainId() uint32 {
+	if m != nil {
+		return m.DomainId
+	}
+	return 0
+}
+
+func (m *OriginDomain) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OriginDomain) GetMailbox() string {
+	if m != nil {
+		return m.Mailbox
+	}
+	return ""
+}
+
+func (m *OriginDomain) GetMerkleTreeHook() string {
+	if m != nil {
+		return m.MerkleTreeHook
+	}
+	return ""
+}
+
+func (m *OriginDomain) GetQuorum() *QuorumOverride {
+	if m != nil {
+		return m.Quorum
+	}
+	return nil
+}
+
+// QuorumOverride is a per-domain quorum.
+type QuorumOverride struct {
+	QuorumNum  uint32     `protobuf:"varint,1,opt,name=quorum_num,json=quorumNum,proto3" json:"quorum_num,omitempty"`
+	QuorumDen  uint32     `protobuf:"varint,2,opt,name=quorum_den,json=quorumDen,proto3" json:"quorum_den,omitempty"`
+	QuorumMode QuorumMode `protobuf:"varint,3,opt,name=quorum_mode,json=quorumMode,proto3,enum=duty.v1.QuorumMode" json:"quorum_mode,omitempty"`
+}
+
+func (m *QuorumOverride) Reset()         { *m = QuorumOverride{} }
+func (m *QuorumOverride) String() string { return proto.CompactTextString(m) }
+func (*QuorumOverride) ProtoMessage()    {}
+func (*QuorumOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d49cce6520b4f28f, []int{1}
+}
+func (m *QuorumOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuorumOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumOverride.Merge(m, src)
+}
+func (m *QuorumOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumOverride proto.InternalMessageInfo
+
+func (m *QuorumOverride) GetQuorumNum() uint32 {
+	if m != nil {
+		return m.QuorumNum
+	}
+	return 0
+}
+
+func (m *QuorumOverride) GetQuorumDen() uint32 {
+	if m != nil {
+		return m.QuorumDen
+	}
+	return 0
+}
+
+func (m *QuorumOverride) GetQuorumMode() QuorumMode {
+	if m != nil {
+		return m.QuorumMode
+	}
+	return QuorumMode_QUORUM_MODE_POWER
+}
+
+// DomainCheckpointConfig overrides a validator's checkpoint key and storage
+// URI for one origin domain. Empty fields fall back to the defaults in
+// DutyMetadata.
+type DomainCheckpointConfig struct {
+	OriginDomain         uint32 `protobuf:"varint,1,opt,name=origin_domain,json=originDomain,proto3" json:"origin_domain,omitempty"`
+	CheckpointPubKey     string `protobuf:"bytes,2,opt,name=checkpoint_pub_key,json=checkpointPubKey,proto3" json:"checkpoint_pub_key,omitempty"`
+	CheckpointStorageUri string `protobuf:"bytes,3,opt,name=checkpoint_storage_uri,json=checkpointStorageUri,proto3" json:"checkpoint_storage_uri,omitempty"`
+}
+
+func (m *DomainCheckpointConfig) Reset()         { *m = DomainCheckpointConfig{} }
+func (m *DomainCheckpointConfig) String() string { return proto.CompactTextString(m) }
+func (*DomainCheckpointConfig) ProtoMessage()    {}
+func (*DomainCheckpointConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d49cce6520b4f28f, []int{2}
+}
+func (m *DomainCheckpointConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainCheckpointConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainCheckpointConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainCheckpointConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainCheckpointConfig.Merge(m, src)
+}
+func (m *DomainCheckpointConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainCheckpointConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainCheckpointConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainCheckpointConfig proto.InternalMessageInfo
+
+func (m *DomainCheckpointConfig) GetOriginDomain() uint32 {
+	if m != nil {
+		return m.OriginDomain
+	}
+	return 0
+}
+
+func (m *DomainCheckpointConfig) GetCheckpointPubKey() string {
+	if m != nil {
+		return m.CheckpointPubKey
+	}
+	return ""
+}
+
+func (m *DomainCheckpointConfig) GetCheckpointStorageUri() string {
+	if m != nil {
+		return m.CheckpointStorageUri
+	}
+	return ""
+}
+
+// ValidatorAnnouncement is a Hyperlane ValidatorAnnounce signature submitted
+// with MsgSetDutyMetadata. The checkpoint key the validator uses for
+// origin_domain signs, EIP-191, over
+// keccak256(abi.encodePacked(
+//
+//	keccak256(abi.encodePacked(origin_domain, mailbox, "HYPERLANE_ANNOUNCEMENT")),
+//	storage_location))
+//
+// where mailbox is the registered mailbox of the domain and storage_location
+// the storage URI the validator uses for it.
+type ValidatorAnnouncement struct {
+	OriginDomain uint32 `protobuf:"varint,1,opt,name=origin_domain,json=originDomain,proto3" json:"origin_domain,omitempty"`
+	// signature is the 65-byte [R || S || V] signature, hex encoded
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ValidatorAnnouncement) Reset()         { *m = ValidatorAnnouncement{} }
+func (m *ValidatorAnnouncement) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAnnouncement) ProtoMessage()    {}
+func (*ValidatorAnnouncement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d49cce6520b4f28f, []int{3}
+}
+func (m *ValidatorAnnouncement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAnnouncement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAnnouncement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAnnouncement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAnnouncement.Merge(m, src)
+}
+func (m *ValidatorAnnouncement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAnnouncement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAnnouncement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAnnouncement proto.InternalMessageInfo
+
+func (m *ValidatorAnnouncement) GetOriginDomain() uint32 {
+	if m != nil {
+		return m.OriginDomain
+	}
+	return 0
+}
+
+func (m *ValidatorAnnouncement) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+// StorageAnnouncement is a verified announcement. Like ValidatorAnnounce, the
+// module keeps every location a checkpoint address announced, in order.
+type StorageAnnouncement struct {
+	OriginDomain uint32 `protobuf:"varint,1,opt,name=origin_domain,json=originDomain,proto3" json:"origin_domain,omitempty"`
+	// validator is the 20-byte address of the announcing checkpoint key (hex)
+	Validator       string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	StorageLocation string `protobuf:"bytes,3,opt,name=storage_location,json=storageLocation,proto3" json:"storage_location,omitempty"`
+	// signature is the announcement signature, hex encoded
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *StorageAnnouncement) Reset()         { *m = StorageAnnouncement{} }
+func (m *StorageAnnouncement) String() string { return proto.CompactTextString(m) }
+func (*StorageAnnouncement) ProtoMessage()    {}
+func (*StorageAnnouncement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d49cce6520b4f28f, []int{4}
+}
+func (m *StorageAnnouncement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageAnnouncement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageAnnouncement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageAnnouncement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageAnnouncement.Merge(m, src)
+}
+func (m *StorageAnnouncement) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageAnnouncement) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageAnnouncement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageAnnouncement proto.InternalMessageInfo
+
+func (m *StorageAnnouncement) GetOriginDomain() uint32 {
+	if m != nil {
+		return m.OriginDomain
+	}
+	return 0
+}
+
+func (m *StorageAnnouncement) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *StorageAnnouncement) GetStorageLocation() string {
+	if m != nil {
+		return m.StorageLocation
+	}
+	return ""
+}
+
+func (m *StorageAnnouncement) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*OriginDomain)(nil), "duty.v1.OriginDomain")
+	proto.RegisterType((*QuorumOverride)(nil), "duty.v1.QuorumOverride")
+	proto.RegisterType((*DomainCheckpointConfig)(nil), "duty.v1.DomainCheckpointConfig")
+	proto.RegisterType((*ValidatorAnnouncement)(nil), "duty.v1.ValidatorAnnouncement")
+	proto.RegisterType((*StorageAnnouncement)(nil), "duty.v1.StorageAnnouncement")
+}
+
+func init() { proto.RegisterFile("duty/v1/domain.proto", fileDescriptor_d49cce6520b4f28f) }
+
+var fileDescriptor_d49cce6520b4f28f = []byte{
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xe3, 0x12, 0x5a, 0xe2, 0xb6, 0x21, 0x72, 0x4b, 0x59, 0xf1, 0x67, 0x15, 0x85, 0x4b,
+	0x90, 0xaa, 0xac, 0x5a, 0xfa, 0x02, 0xa5, 0x11, 0x02, 0xf1, 0xa7, 0x10, 0x0a, 0x87, 0x5e, 0x56,
+	0xce, 0xee, 0x90, 0x58, 0x89, 0x3d, 0x5b, 0xc7, 0x8e, 0x9a, 0x3b, 0x0f, 0xc0, 0x2b, 0xf0, 0x0e,
+	0x3c, 0x04, 0xc7, 0x1e, 0x39, 0xa2, 0xe4, 0x45, 0x50, 0xbc, 0xde, 0x24, 0xed, 0x09, 0xf5, 0xe6,
+	0x7c, 0xbf, 0x99, 0xc9, 0x37, 0xdf, 0x6a, 0xe8, 0x6e, 0x6a, 0xcd, 0x24, 0x1a, 0x1f, 0x44, 0x29,
+	0x4a, 0x2e, 0x54, 0x2b, 0xd3, 0x68, 0x90, 0x6d, 0xcc, 0xd5, 0xd6, 0xf8, 0xe0, 0xd1, 0x02, 0x67,
+	0x5c, 0x73, 0x39, 0xca, 0x71, 0xe3, 0x17, 0xa1, 0x5b, 0xa7, 0x5a, 0xf4, 0x84, 0x6a, 0xbb, 0x2e,
+	0xf6, 0x98, 0x56, 0xf2, 0xfe, 0x58, 0xa4, 0x01, 0xa9, 0x93, 0xe6, 0x76, 0xe7, 0x5e, 0x2e, 0xbc,
+	0x49, 0x19, 0xa3, 0x65, 0xc5, 0x25, 0x04, 0x6b, 0x75, 0xd2, 0xac, 0x74, 0xdc, 0x9b, 0x05, 0x74,
+	0x43, 0x72, 0x31, 0xec, 0xe2, 0x65, 0x70, 0xc7, 0xc9, 0xc5, 0x4f, 0xd6, 0xa4, 0x35, 0x09, 0x7a,
+	0x30, 0x84, 0xd8, 0x68, 0x80, 0xb8, 0x8f, 0x38, 0x08, 0xca, 0xae, 0xa4, 0x9a, 0xeb, 0x67, 0x1a,
+	0xe0, 0x35, 0xe2, 0x80, 0x45, 0x74, 0xfd, 0xc2, 0xa2, 0xb6, 0x32, 0xb8, 0x5b, 0x27, 0xcd, 0xcd,
+	0xc3, 0x87, 0x2d, 0xef, 0xba, 0xf5, 0xc9, 0xc9, 0xa7, 0x63, 0xd0, 0x5a, 0xa4, 0xd0, 0xf1, 0x65,
+	0x8d, 0xef, 0x84, 0x56, 0xaf, 0x23, 0xf6, 0x94, 0xd2, 0x1c, 0xc6, 0xca, 0x4a, 0xef, 0xbc, 0x92,
+	0x2b, 0x1f, 0xac, 0x5c, 0xc1, 0x29, 0xa8, 0x60, 0x6d, 0x15, 0xb7, 0x41, 0xb1, 0x23, 0xba, 0xe9,
+	0xb1, 0xc4, 0x14, 0xdc, 0x26, 0xd5, 0xc3, 0x9d, 0x1b, 0x36, 0xde, 0x63, 0x0a, 0x1d, 0x7a, 0xb1,
+	0x78, 0x37, 0x7e, 0x12, 0xba, 0x97, 0xe7, 0x76, 0xd2, 0x87, 0x64, 0x90, 0xa1, 0x50, 0xe6, 0x04,
+	0xd5, 0x37, 0xd1, 0x63, 0xcf, 0xe8, 0x36, 0xba, 0x5c, 0xe3, 0x3c, 0x3d, 0xef, 0x68, 0x0b, 0x57,
+	0xc3, 0xde, 0xa7, 0x2c, 0x59, 0x34, 0xc6, 0x99, 0xed, 0xc6, 0x03, 0x98, 0xf8, 0x74, 0x6b, 0x4b,
+	0xf2, 0xd1, 0x76, 0xdf, 0xc2, 0x84, 0x1d, 0xd1, 0xbd, 0x95, 0xea, 0x91, 0x41, 0xcd, 0x7b, 0x10,
+	0x5b, 0x2d, 0x7c, 0xf0, 0xbb, 0x4b, 0xfa, 0x39, 0x87, 0x5f, 0xb4, 0x68, 0x9c, 0xd3, 0x07, 0x5f,
+	0xf9, 0x50, 0xa4, 0xdc, 0xa0, 0x3e, 0x56, 0x0a, 0xad, 0x4a, 0x40, 0x82, 0x32, 0xff, 0xe7, 0xf0,
+	0x09, 0xad, 0x8c, 0x44, 0x4f, 0x71, 0x63, 0x75, 0xf1, 0xd9, 0x97, 0xc2, 0x7c, 0xff, 0x1d, 0xff,
+	0x57, 0xb7, 0x1a, 0x3d, 0x2e, 0x8c, 0x15, 0xa3, 0x17, 0x02, 0x7b, 0x4e, 0x6b, 0xc5, 0x86, 0x43,
+	0x4c, 0xb8, 0x11, 0xa8, 0xfc, 0x9a, 0xf7, 0xbd, 0xfe, 0xce, 0xcb, 0xd7, 0x3d, 0x96, 0x6f, 0x78,
+	0x7c, 0xf9, 0xea, 0xf7, 0x34, 0x24, 0x57, 0xd3, 0x90, 0xfc, 0x9d, 0x86, 0xe4, 0xc7, 0x2c, 0x2c,
+	0x5d, 0xcd, 0xc2, 0xd2, 0x9f, 0x59, 0x58, 0x3a, 0xdf, 0xef, 0x09, 0xd3, 0xb7, 0xdd, 0x56, 0x82,
+	0x32, 0x3a, 0xeb, 0xc3, 0xb1, 0x36, 0x22, 0xb1, 0x43, 0x37, 0x33, 0x6a, 0xcf, 0x8f, 0xe5, 0x32,
+	0x72, 0x37, 0x63, 0x26, 0x19, 0x8c, 0xba, 0xeb, 0xee, 0x60, 0x5e, 0xfc, 0x1b, 0x00, 0xb7, 0x8c,
+	0x57, 0xbb, 0x67, 0x03, 0x00, 0x00,
+}
+
+func (m *OriginDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OriginDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OriginDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quorum != nil {
+		{
+			size, err := m.Quorum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MerkleTreeHook) > 0 {
+		i -= len(m.MerkleTreeHook)
+		copy(dAtA[i:], m.MerkleTreeHook)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.MerkleTreeHook)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Mailbox) > 0 {
+		i -= len(m.Mailbox)
+		copy(dAtA[i:], m.Mailbox)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Mailbox)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DomainId != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.DomainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumMode != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.QuorumMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QuorumDen != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.QuorumDen))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QuorumNum != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.QuorumNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainCheckpointConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainCheckpointConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainCheckpointConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CheckpointStorageUri) > 0 {
+		i -= len(m.CheckpointStorageUri)
+		copy(dAtA[i:], m.CheckpointStorageUri)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.CheckpointStorageUri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CheckpointPubKey) > 0 {
+		i -= len(m.CheckpointPubKey)
+		copy(dAtA[i:], m.CheckpointPubKey)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.CheckpointPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OriginDomain != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.OriginDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAnnouncement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAnnouncement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAnnouncement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OriginDomain != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.OriginDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageAnnouncement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageAnnouncement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageAnnouncement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StorageLocation) > 0 {
+		i -= len(m.StorageLocation)
+		copy(dAtA[i:], m.StorageLocation)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.StorageLocation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OriginDomain != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.OriginDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDomain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDomain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OriginDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DomainId != 0 {
+		n += 1 + sovDomain(uint64(m.DomainId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	l = len(m.Mailbox)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	l = len(m.MerkleTreeHook)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	return n
+}
+
+func (m *QuorumOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuorumNum != 0 {
+		n += 1 + sovDomain(uint64(m.QuorumNum))
+	}
+	if m.QuorumDen != 0 {
+		n += 1 + sovDomain(uint64(m.QuorumDen))
+	}
+	if m.QuorumMode != 0 {
+		n += 1 + sovDomain(uint64(m.QuorumMode))
+	}
+	return n
+}
+
+func (m *DomainCheckpointConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OriginDomain != 0 {
+		n += 1 + sovDomain(uint64(m.OriginDomain))
+	}
+	l = len(m.CheckpointPubKey)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	l = len(m.CheckpointStorageUri)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorAnnouncement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OriginDomain != 0 {
+		n += 1 + sovDomain(uint64(m.OriginDomain))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	return n
+}
+
+func (m *StorageAnnouncement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OriginDomain != 0 {
+		n += 1 + sovDomain(uint64(m.OriginDomain))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	l = len(m.StorageLocation)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	return n
+}
+
+func sovDomain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDomain(x uint64) (n int) {
+	return sovDomain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OriginDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OriginDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OriginDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			m.DomainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mailbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mailbox = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quorum == nil {
+				m.Quorum = &QuorumOverride{}
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumNum", wireType)
+			}
+			m.QuorumNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumDen", wireType)
+			}
+			m.QuorumDen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumDen |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumMode", wireType)
+			}
+			m.QuorumMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumMode |= QuorumMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainCheckpointConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainCheckpointConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainCheckpointConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDomain", wireType)
+			}
+			m.OriginDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointStorageUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointStorageUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAnnouncement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAnnouncement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAnnouncement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDomain", wireType)
+			}
+			m.OriginDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageAnnouncement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageAnnouncement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageAnnouncement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDomain", wireType)
+			}
+			m.OriginDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDomain(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDomain
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDomain
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDomain
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDomain        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDomain          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDomain = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOriginDomain_Validate(t *testing.T) {
+	domain := OriginDomain{
+		DomainId:       1,
+		Name:           "ethereum",
+		Mailbox:        "0x" + strings.Repeat("AB", 32),
+		MerkleTreeHook: "0x" + strings.Repeat("cd", 32),
+	}
+	assert.NoError(t, domain.Validate())
+
+	noID := domain
+	noID.DomainId = 0
+	assert.ErrorIs(t, noID.Validate(), ErrInvalidOriginDomain)
+
+	badHook := domain
+	badHook.MerkleTreeHook = "0x1234"
+	assert.ErrorIs(t, badHook.Validate(), ErrInvalidOriginDomain)
+
+	badQuorum := domain
+	badQuorum.Quorum = &QuorumOverride{QuorumNum: 3, QuorumDen: 2}
+	assert.ErrorIs(t, badQuorum.Validate(), ErrInvalidOriginDomain)
+
+	domain.Normalize()
+	assert.Equal(t, "0x"+strings.Repeat("ab", 32), domain.Mailbox)
+}
+
+func TestDutyMetadata_DomainConfigs(t *testing.T) {
+	newKey := func() string {
+		priv, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		return "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	}
+	defaultKey, domainKey := newKey(), newKey()
+
+	meta := DutyMetadata{
+		CheckpointPubKey:     defaultKey,
+		CheckpointStorageUri: "s3://bucket/default/",
+		DomainConfigs: []*DomainCheckpointConfig{
+			{OriginDomain: 1, CheckpointPubKey: domainKey},
+			{OriginDomain: 2, CheckpointStorageUri: "s3://bucket/two/"},
+		},
+	}
+	require.NoError(t, meta.ValidateDomainConfigs())
+
+	key, uri := meta.CheckpointConfigFor(1)
+	assert.Equal(t, domainKey, key)
+	assert.Equal(t, "s3://bucket/default/", uri)
+	key, uri = meta.CheckpointConfigFor(2)
+	assert.Equal(t, defaultKey, key)
+	assert.Equal(t, "s3://bucket/two/", uri)
+	key, _ = meta.CheckpointConfigFor(3)
+	assert.Equal(t, defaultKey, key)
+
+	assert.Equal(t, []string{defaultKey, domainKey}, meta.CheckpointPubKeys())
+
+	dup := meta
+	dup.DomainConfigs = append([]*DomainCheckpointConfig{{OriginDomain: 1, CheckpointPubKey: defaultKey}}, meta.DomainConfigs...)
+	assert.ErrorIs(t, dup.ValidateDomainConfigs(), ErrInvalidOriginDomain)
+
+	empty := meta
+	empty.DomainConfigs = []*DomainCheckpointConfig{{OriginDomain: 1}}
+	assert.ErrorIs(t, empty.ValidateDomainConfigs(), ErrInvalidOriginDomain)
+}
+
+func TestDutySetSnapshot_ForDomain(t *testing.T) {
+	snap := DutySetSnapshot{
+		Validators: []*DutyValidator{{
+			ValConsAddr:      "a",
+			VotingPower:      "10",
+			CheckpointPubKey: "default",
+			DomainConfigs:    []*DomainCheckpointConfig{{OriginDomain: 7, CheckpointPubKey: "seven"}},
+		}},
+		QuorumNum: 2,
+		QuorumDen: 3,
+	}
+
+	resolved := snap.ForDomain(7, nil)
+	assert.Equal(t, "seven", resolved.Validators[0].CheckpointPubKey)
+	assert.Equal(t, "default", snap.Validators[0].CheckpointPubKey, "the snapshot itself is not modified")
+	assert.Equal(t, uint32(2), resolved.QuorumNum)
+
+	domain := &OriginDomain{DomainId: 7, Quorum: &QuorumOverride{QuorumNum: 1, QuorumDen: 2, QuorumMode: QuorumMode_QUORUM_MODE_COUNT}}
+	resolved = snap.ForDomain(7, domain)
+	assert.Equal(t, uint32(1), resolved.QuorumNum)
+	assert.Equal(t, uint32(2), resolved.QuorumDen)
+	assert.Equal(t, QuorumMode_QUORUM_MODE_COUNT, resolved.QuorumMode)
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/duty module sentinel errors
+var (
+	ErrInvalidCheckpointKey = errorsmod.Register(ModuleName, 2, "invalid checkpoint key")
+	ErrInvalidAttestation   = errorsmod.Register(ModuleName, 3, "invalid checkpoint key attestation")
+	ErrInvalidBinding       = errorsmod.Register(ModuleName, 4, "invalid checkpoint key binding")
+	ErrSnapshotNotFound     = errorsmod.Register(ModuleName, 5, "duty set snapshot not found")
+	ErrInvalidCheckpoint    = errorsmod.Register(ModuleName, 6, "invalid checkpoint")
+	ErrNotInDutySet         = errorsmod.Register(ModuleName, 7, "validator not in active duty set")
+	ErrDuplicateSignature   = errorsmod.Register(ModuleName, 8, "checkpoint already signed by validator")
+	ErrInvalidEquivocation  = errorsmod.Register(ModuleName, 9, "invalid checkpoint equivocation evidence")
+	ErrCheckpointKeyInUse   = errorsmod.Register(ModuleName, 10, "checkpoint key already registered by another validator")
+	ErrInvalidSigner        = errorsmod.Register(ModuleName, 11, "expected authority account as only signer")
+	ErrInvalidOriginDomain  = errorsmod.Register(ModuleName, 12, "invalid origin domain")
+	ErrInvalidAnnouncement  = errorsmod.Register(ModuleName, 13, "invalid validator announcement")
+	ErrInvalidStorageURI    = errorsmod.Register(ModuleName, 14, "invalid checkpoint storage uri")
+	ErrInvalidNonce         = errorsmod.Register(ModuleName, 15, "invalid duty nonce")
+	ErrCheckpointKeyChange  = errorsmod.Register(ModuleName, 16, "checkpoint keys only change through rotation or binding")
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper is the part of the staking keeper the duty module uses to
+// read the validator set and punish validators.
+type StakingKeeper interface {
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
+	GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error)
+	PowerReduction(ctx context.Context) math.Int
+	Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) (math.Int, error)
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+}
+
+// SlashingKeeper is the part of the slashing keeper the duty module uses to
+// punish checkpoint equivocation like double signing.
+type SlashingKeeper interface {
+	IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool
+	SlashFractionDoubleSign(ctx context.Context) (math.LegacyDec, error)
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+	Tombstone(ctx context.Context, consAddr sdk.ConsAddress) error
+}
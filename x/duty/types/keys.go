@@ -1,17 +1,60 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	ModuleName = "duty"
 	StoreKey   = ModuleName
 	RouterKey  = ModuleName
 )
 
+// Collection prefixes. The byte layout of every collection matches the
+// hand-encoded keys used before the keeper moved to collections, so existing
+// state is read without a migration.
 var (
-	// KV prefixes
 	// DutyMeta: validator-consensus-address -> DutyMetadata
-	DutyMetaPrefix = []byte{0x01}
+	DutyMetaPrefix = collections.NewPrefix(1)
+	// DutyNonce: validator-consensus-address -> uint64
+	DutyNoncePrefix = collections.NewPrefix(2)
+	// DutySetSnapshot: height -> DutySetSnapshot
+	DutySetSnapshotPrefix = collections.NewPrefix(3)
+	// DutySetEpoch: epoch -> height
+	DutySetEpochPrefix = collections.NewPrefix(4)
+	// Checkpoint: origin-domain | index | digest -> Checkpoint
+	CheckpointPrefix = collections.NewPrefix(5)
+	// CheckpointSigningInfo: validator-consensus-address -> CheckpointSigningInfo
+	CheckpointSigningInfoPrefix = collections.NewPrefix(6)
+	// CheckpointMissed: validator-consensus-address | window-index (key set)
+	CheckpointMissedPrefix = collections.NewPrefix(7)
+	// CheckpointAddress: checkpoint-key-address (20 bytes) -> validator-consensus-address,
+	// a unique index over DutyMeta
+	CheckpointAddressPrefix = collections.NewPrefix(8)
+	// Params: module parameters
+	ParamsKey = collections.NewPrefix(9)
+	// QuorumCoverageLow: whether registered checkpoint keys held less than
+	// quorum power at the last EndBlock
+	QuorumCoverageLowKey = collections.NewPrefix(10)
+	// OriginDomains: domain ID -> OriginDomain
+	OriginDomainPrefix = collections.NewPrefix(11)
+	// PendingKeyRotation: activation-height | validator-consensus-address (key set)
+	PendingKeyRotationPrefix = collections.NewPrefix(12)
+	// DutyMetadataTombstone: validator-consensus-address -> DutyMetadataTombstone
+	DutyMetadataTombstonePrefix = collections.NewPrefix(13)
+	// TombstoneCheckpointAddress: checkpoint-key-address | validator-consensus-address
+	// (key set), indexing tombstones by every key they held
+	TombstoneCheckpointAddressPrefix = collections.NewPrefix(14)
+	// ConsensusKeyMigration: old-consensus-address -> ConsensusKeyMigration
+	ConsensusKeyMigrationPrefix = collections.NewPrefix(15)
+	// ConsensusKeyMigrationByNew: new-consensus-address | old-consensus-address
+	// (key set), the reverse of ConsensusKeyMigration
+	ConsensusKeyMigrationByNewPrefix = collections.NewPrefix(16)
+	// CheckpointLivenessQueue: liveness-deadline | digest -> origin-domain | index,
+	// the quorum checkpoints whose signers are still to be recorded
+	CheckpointLivenessQueuePrefix = collections.NewPrefix(17)
+	// ValidatorConsAddr: validator-operator-address -> validator-consensus-address,
+	// the address the validator's duty state is stored under
+	ValidatorConsAddrPrefix = collections.NewPrefix(18)
+	// StorageAnnouncement: origin-domain | checkpoint-key-address | sequence ->
+	// StorageAnnouncement, every verified announcement in the order made
+	StorageAnnouncementPrefix = collections.NewPrefix(19)
 )
-
-func DutyMetaKey(valConsAddr []byte) []byte {
-	return append(DutyMetaPrefix, valConsAddr...)
-}
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// MaxStorageURILength is the upper bound of the max_storage_uri_length param.
+// ValidateBasic enforces it, since it cannot read the params.
+const MaxStorageURILength = 2048
+
+// storageURISchemes are the schemes a checkpoint storage location may use,
+// the ones Hyperlane agents can read checkpoints from.
+var storageURISchemes = map[string]bool{
+	"s3":    true,
+	"gs":    true,
+	"file":  true,
+	"https": true,
+}
+
+// ValidateStorageURI checks that uri is at most maxLength bytes, parses, uses
+// an allowed scheme and names a bucket, host or path.
+func ValidateStorageURI(uri string, maxLength uint32) error {
+	if uri == "" {
+		return ErrInvalidStorageURI.Wrap("empty")
+	}
+	if len(uri) > int(maxLength) {
+		return ErrInvalidStorageURI.Wrapf("%d bytes exceeds the limit of %d", len(uri), maxLength)
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return ErrInvalidStorageURI.Wrap(err.Error())
+	}
+	if !storageURISchemes[u.Scheme] {
+		return ErrInvalidStorageURI.Wrapf("scheme %q is not one of s3, gs, file or https", u.Scheme)
+	}
+	if u.User != nil {
+		return ErrInvalidStorageURI.Wrap("must not carry credentials")
+	}
+	if u.Scheme == "file" {
+		if u.Path == "" {
+			return ErrInvalidStorageURI.Wrap("file uri without a path")
+		}
+	} else if u.Host == "" {
+		return ErrInvalidStorageURI.Wrapf("%s uri without a bucket or host", u.Scheme)
+	}
+	return nil
+}
+
+// ValidateStorageURIs checks the default storage URI and every per-domain
+// override.
+func (m DutyMetadata) ValidateStorageURIs(maxLength uint32) error {
+	if err := ValidateStorageURI(m.CheckpointStorageUri, maxLength); err != nil {
+		return err
+	}
+	for _, c := range m.DomainConfigs {
+		if c == nil || c.CheckpointStorageUri == "" {
+			continue
+		}
+		if err := ValidateStorageURI(c.CheckpointStorageUri, maxLength); err != nil {
+			return fmt.Errorf("domain %d: %w", c.OriginDomain, err)
+		}
+	}
+	return nil
+}
+
+// Normalize rewrites the default, per-domain and pending checkpoint keys to
+// their canonical form. It fails on a key that does not parse. An empty
+// default key is kept: metadata set before any key is bound has none.
+func (m *DutyMetadata) Normalize() error {
+	var err error
+	if m.CheckpointPubKey != "" {
+		if m.CheckpointPubKey, err = NormalizeCheckpointKey(m.CheckpointPubKey); err != nil {
+			return err
+		}
+	}
+	for _, c := range m.DomainConfigs {
+		if c == nil || c.CheckpointPubKey == "" {
+			continue
+		}
+		if c.CheckpointPubKey, err = NormalizeCheckpointKey(c.CheckpointPubKey); err != nil {
+			return fmt.Errorf("domain %d: %w", c.OriginDomain, err)
+		}
+	}
+	if m.PendingKey != nil {
+		if m.PendingKey.CheckpointPubKey, err = NormalizeCheckpointKey(m.PendingKey.CheckpointPubKey); err != nil {
+			return fmt.Errorf("pending key: %w", err)
+		}
+	}
+	return nil
+}
+
+// WithStorageURIs returns m with the default and per-domain storage URIs of
+// update. The checkpoint keys of m are kept: a key in update must be empty or
+// match the registered key, since keys only change through rotation or
+// binding. Per-domain entries of m that carry a key are kept even when update
+// omits them.
+func (m DutyMetadata) WithStorageURIs(update DutyMetadata) (DutyMetadata, error) {
+	if err := checkSameKey(m.CheckpointPubKey, update.CheckpointPubKey); err != nil {
+		return DutyMetadata{}, err
+	}
+	existing := make(map[uint32]string, len(m.DomainConfigs))
+	for _, c := range m.DomainConfigs {
+		if c != nil && c.CheckpointPubKey != "" {
+			existing[c.OriginDomain] = c.CheckpointPubKey
+		}
+	}
+
+	out := m
+	out.CheckpointStorageUri = update.CheckpointStorageUri
+	out.DomainConfigs = nil
+	updated := make(map[uint32]bool, len(update.DomainConfigs))
+	for _, c := range update.DomainConfigs {
+		if c == nil {
+			continue
+		}
+		if err := checkSameKey(existing[c.OriginDomain], c.CheckpointPubKey); err != nil {
+			return DutyMetadata{}, fmt.Errorf("domain %d: %w", c.OriginDomain, err)
+		}
+		updated[c.OriginDomain] = true
+		if existing[c.OriginDomain] == "" && c.CheckpointStorageUri == "" {
+			continue
+		}
+		out.DomainConfigs = append(out.DomainConfigs, &DomainCheckpointConfig{
+			OriginDomain:         c.OriginDomain,
+			CheckpointPubKey:     existing[c.OriginDomain],
+			CheckpointStorageUri: c.CheckpointStorageUri,
+		})
+	}
+	for _, c := range m.DomainConfigs {
+		if c != nil && c.CheckpointPubKey != "" && !updated[c.OriginDomain] {
+			out.DomainConfigs = append(out.DomainConfigs, &DomainCheckpointConfig{
+				OriginDomain:     c.OriginDomain,
+				CheckpointPubKey: c.CheckpointPubKey,
+			})
+		}
+	}
+	return out, nil
+}
+
+// checkSameKey fails unless key is empty or the same checkpoint key as
+// registered.
+func checkSameKey(registered, key string) error {
+	if key == "" {
+		return nil
+	}
+	normalized, err := NormalizeCheckpointKey(key)
+	if err != nil {
+		return err
+	}
+	if registered == "" || normalized != registered {
+		return ErrCheckpointKeyChange.Wrapf("%s is not the registered key", normalized)
+	}
+	return nil
+}
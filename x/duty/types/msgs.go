@@ -1,39 +1,200 @@
 package types
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-type DutyMetadata struct {
-	// ECDSA secp256k1 public key used to sign Hyperlane checkpoints (hex or 0x…)
-	CheckpointPubKey string `json:"checkpoint_pub_key"`
-	// Public location for signatures (e.g., s3://bucket/prefix or https://…)
-	CheckpointStorageURI string `json:"checkpoint_storage_uri"`
+const (
+	TypeMsgSetDutyMetadata = "set_duty_metadata"
+)
+
+func (m *MsgSetDutyMetadata) Route() string { return RouterKey }
+func (m *MsgSetDutyMetadata) Type() string  { return TypeMsgSetDutyMetadata }
+func (m *MsgSetDutyMetadata) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.ValAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{sdk.AccAddress(addr.Bytes())}
+}
+func (m *MsgSetDutyMetadata) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid valoper")
+	}
+	if len(m.Metadata.CheckpointStorageUri) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing metadata")
+	}
+	// The key is optional; when given it must match the registered one
+	if m.Metadata.CheckpointPubKey != "" {
+		if _, err := NormalizeCheckpointKey(m.Metadata.CheckpointPubKey); err != nil {
+			return err
+		}
+	}
+	// The max_storage_uri_length param is checked when the message executes
+	if err := m.Metadata.ValidateStorageURIs(MaxStorageURILength); err != nil {
+		return err
+	}
+	if err := m.Metadata.ValidateDomainConfigs(); err != nil {
+		return err
+	}
+	return ValidateAnnouncements(m.Announcements)
 }
 
 const (
-	TypeMsgSetDutyMetadata = "set_duty_metadata"
+	TypeMsgRotateCheckpointKey = "rotate_checkpoint_key"
+	TypeMsgBindCheckpointKey   = "bind_checkpoint_key"
 )
 
-type MsgSetDutyMetadata struct {
-	// signer is the consensus validator operator address (valoper…)
-	Signer   string       `json:"signer"`
-	Metadata DutyMetadata `json:"metadata"`
+func (m *MsgRotateCheckpointKey) Route() string { return RouterKey }
+func (m *MsgRotateCheckpointKey) Type() string  { return TypeMsgRotateCheckpointKey }
+func (m *MsgRotateCheckpointKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.ValAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{sdk.AccAddress(addr.Bytes())}
+}
+func (m *MsgRotateCheckpointKey) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid valoper")
+	}
+	if _, err := NormalizeCheckpointKey(m.NewCheckpointPubKey); err != nil {
+		return err
+	}
+	if err := validateEthSignature(m.AttestationSignature); err != nil {
+		return ErrInvalidAttestation.Wrapf("attestation signature: %s", err)
+	}
+	return nil
+}
+
+func (m *MsgBindCheckpointKey) Route() string { return RouterKey }
+func (m *MsgBindCheckpointKey) Type() string  { return TypeMsgBindCheckpointKey }
+func (m *MsgBindCheckpointKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.ValAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{sdk.AccAddress(addr.Bytes())}
+}
+func (m *MsgBindCheckpointKey) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid valoper")
+	}
+	if _, err := sdk.ConsAddressFromBech32(m.ConsensusAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid consensus address")
+	}
+	if _, err := NormalizeCheckpointKey(m.CheckpointPubKey); err != nil {
+		return err
+	}
+	if err := validateEthSignature(m.BindingSignature); err != nil {
+		return ErrInvalidBinding.Wrapf("binding signature: %s", err)
+	}
+	if sig, err := DecodeHex(m.ConsensusSignature); err != nil || len(sig) == 0 {
+		return ErrInvalidBinding.Wrap("consensus signature must be non-empty hex")
+	}
+	return nil
+}
+
+// validateEthSignature checks that sigHex is a 65-byte [R || S || V]
+// signature.
+func validateEthSignature(sigHex string) error {
+	sig, err := DecodeHex(sigHex)
+	if err != nil {
+		return fmt.Errorf("not hex: %w", err)
+	}
+	if len(sig) != 65 {
+		return fmt.Errorf("must be 65 bytes, got %d", len(sig))
+	}
+	return nil
 }
 
-func (m MsgSetDutyMetadata) Route() string { return RouterKey }
-func (m MsgSetDutyMetadata) Type() string  { return TypeMsgSetDutyMetadata }
-func (m MsgSetDutyMetadata) GetSigners() []sdk.AccAddress {
+const (
+	TypeMsgSubmitCheckpointSignature = "submit_checkpoint_signature"
+)
+
+func (m *MsgSubmitCheckpointSignature) Route() string { return RouterKey }
+func (m *MsgSubmitCheckpointSignature) Type() string  { return TypeMsgSubmitCheckpointSignature }
+func (m *MsgSubmitCheckpointSignature) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.ValAddressFromBech32(m.Signer)
 	return []sdk.AccAddress{sdk.AccAddress(addr.Bytes())}
 }
-func (m MsgSetDutyMetadata) ValidateBasic() error {
+func (m *MsgSubmitCheckpointSignature) ValidateBasic() error {
 	if _, err := sdk.ValAddressFromBech32(m.Signer); err != nil {
-		return sdkerrors.Wrap(err, "invalid valoper")
+		return errorsmod.Wrap(err, "invalid valoper")
+	}
+	if _, err := m.Digest(); err != nil {
+		return err
+	}
+	if len(m.Signature) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing signature")
+	}
+	return nil
+}
+
+const (
+	TypeMsgSubmitCheckpointEquivocation = "submit_checkpoint_equivocation"
+)
+
+func (m *MsgSubmitCheckpointEquivocation) Route() string { return RouterKey }
+func (m *MsgSubmitCheckpointEquivocation) Type() string  { return TypeMsgSubmitCheckpointEquivocation }
+func (m *MsgSubmitCheckpointEquivocation) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Submitter)
+	return []sdk.AccAddress{addr}
+}
+func (m *MsgSubmitCheckpointEquivocation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Submitter); err != nil {
+		return errorsmod.Wrap(err, "invalid submitter")
+	}
+	// Whether the two checkpoints are conflicting evidence is decided by
+	// Keeper.HandleCheckpointEquivocation
+	return nil
+}
+
+const (
+	TypeMsgUpdateParams = "update_params"
+)
+
+func (m *MsgUpdateParams) Route() string { return RouterKey }
+func (m *MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority")
+	}
+	if err := m.Params.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+const (
+	TypeMsgRegisterOriginDomain = "register_origin_domain"
+	TypeMsgRemoveOriginDomain   = "remove_origin_domain"
+)
+
+func (m *MsgRegisterOriginDomain) Route() string { return RouterKey }
+func (m *MsgRegisterOriginDomain) Type() string  { return TypeMsgRegisterOriginDomain }
+func (m *MsgRegisterOriginDomain) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+func (m *MsgRegisterOriginDomain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority")
+	}
+	return m.Domain.Validate()
+}
+
+func (m *MsgRemoveOriginDomain) Route() string { return RouterKey }
+func (m *MsgRemoveOriginDomain) Type() string  { return TypeMsgRemoveOriginDomain }
+func (m *MsgRemoveOriginDomain) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+func (m *MsgRemoveOriginDomain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority")
 	}
-	if len(m.Metadata.CheckpointPubKey) == 0 || len(m.Metadata.CheckpointStorageURI) == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing metadata")
+	if m.DomainId == 0 {
+		return ErrInvalidOriginDomain.Wrap("domain id cannot be zero")
 	}
 	return nil
 }
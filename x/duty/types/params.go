@@ -1,44 +1,110 @@
 package types
 
 import (
-	"context"
 	"fmt"
 
-	"cosmossdk.io/core/address"
-	"cosmossdk.io/depinject"
-	paramtypes "cosmossdk.io/x/params/types"
+	"cosmossdk.io/math"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
 	DefaultQuorumNum = uint32(2)
 	DefaultQuorumDen = uint32(3)
+	// DefaultSnapshotRetention keeps every duty set snapshot
+	DefaultSnapshotRetention = uint64(0)
+	// DefaultEpochLength is the number of blocks between duty set transitions
+	DefaultEpochLength = uint64(100)
+	// DefaultSignedCheckpointsWindow is the number of quorum checkpoints over
+	// which validator liveness is measured
+	DefaultSignedCheckpointsWindow = int64(100)
+	// DefaultQuorumMode applies the quorum fraction to voting power
+	DefaultQuorumMode = QuorumMode_QUORUM_MODE_POWER
+	// DefaultMaxStorageURILength is the longest storage URI validators may
+	// register
+	DefaultMaxStorageURILength = uint32(512)
+	// DefaultKeyRotationDelay is the number of blocks a rotated checkpoint
+	// key stays pending before it replaces the current key
+	DefaultKeyRotationDelay = uint64(100)
+	// DefaultCheckpointSigningGracePeriod is the number of blocks after
+	// quorum in which checkpoint signatures still count for liveness
+	DefaultCheckpointSigningGracePeriod = uint64(20)
+
+	// MaxKeyRotationDelay is the longest a rotated checkpoint key may stay
+	// pending, about a week at 6s blocks
+	MaxKeyRotationDelay = uint64(100_000)
+	// MinSnapshotRetention is the fewest epochs a non-zero retention may
+	// keep, so the previous duty set survives the epoch transition
+	MinSnapshotRetention = uint64(2)
+)
+
+var (
+	DefaultMinSignedPerWindow             = math.LegacyNewDecWithPrec(5, 1)
+	DefaultSlashFractionMissedCheckpoints = math.LegacyZeroDec()
 )
 
 var (
 	KeyQuorumNumerator   = []byte("QuorumNumerator")
 	KeyQuorumDenominator = []byte("QuorumDenominator")
-)
+	KeySnapshotRetention = []byte("SnapshotRetention")
+	KeyEpochLength       = []byte("EpochLength")
+	KeyQuorumMode        = []byte("QuorumMode")
 
-type Params struct {
-	QuorumNumerator   uint32 `json:"quorum_num" yaml:"quorum_num"`
-	QuorumDenominator uint32 `json:"quorum_den" yaml:"quorum_den"`
-}
+	KeySignedCheckpointsWindow        = []byte("SignedCheckpointsWindow")
+	KeyMinSignedPerWindow             = []byte("MinSignedPerWindow")
+	KeySlashFractionMissedCheckpoints = []byte("SlashFractionMissedCheckpoints")
+)
 
 func (p Params) Validate() error {
 	if p.QuorumNumerator == 0 || p.QuorumDenominator == 0 || p.QuorumNumerator > p.QuorumDenominator {
 		return fmt.Errorf("invalid quorum %d/%d", p.QuorumNumerator, p.QuorumDenominator)
 	}
-	return nil
+	if err := validateQuorumMode(p.QuorumMode); err != nil {
+		return err
+	}
+	if err := validateEpochLength(p.EpochLength); err != nil {
+		return err
+	}
+	if err := validateSnapshotRetention(p.SnapshotRetention); err != nil {
+		return err
+	}
+	if err := validateKeyRotationDelay(p.KeyRotationDelay); err != nil {
+		return err
+	}
+	// Liveness is recorded against the checkpoint's duty set, which must not
+	// be pruned before the grace period ends. A set can be pruned
+	// (retention - 1) epochs after the epoch it was last used in.
+	if r := p.SnapshotRetention; r > 0 && p.CheckpointSigningGracePeriod >= (r-1)*p.EpochLength {
+		return fmt.Errorf("checkpoint signing grace period %d must be less than %d blocks, (snapshot retention - 1) epochs",
+			p.CheckpointSigningGracePeriod, (r-1)*p.EpochLength)
+	}
+	if err := validateSignedCheckpointsWindow(p.SignedCheckpointsWindow); err != nil {
+		return err
+	}
+	if err := validateFraction(p.MinSignedPerWindow); err != nil {
+		return fmt.Errorf("min signed per window: %w", err)
+	}
+	if err := validateFraction(p.SlashFractionMissedCheckpoints); err != nil {
+		return fmt.Errorf("slash fraction missed checkpoints: %w", err)
+	}
+	return validateMaxStorageURILength(p.MaxStorageUriLength)
 }
 
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// ParamSetPairs lists the params of the legacy x/params subspace, which the
+// v2 -> v3 migration reads. Params added since have no pair.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyQuorumNumerator, &p.QuorumNumerator, validateQuorumNumerator),
 		paramtypes.NewParamSetPair(KeyQuorumDenominator, &p.QuorumDenominator, validateQuorumDenominator),
+		paramtypes.NewParamSetPair(KeySnapshotRetention, &p.SnapshotRetention, validateSnapshotRetention),
+		paramtypes.NewParamSetPair(KeyEpochLength, &p.EpochLength, validateEpochLength),
+		paramtypes.NewParamSetPair(KeyQuorumMode, &p.QuorumMode, validateQuorumMode),
+		paramtypes.NewParamSetPair(KeySignedCheckpointsWindow, &p.SignedCheckpointsWindow, validateSignedCheckpointsWindow),
+		paramtypes.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow, validateFraction),
+		paramtypes.NewParamSetPair(KeySlashFractionMissedCheckpoints, &p.SlashFractionMissedCheckpoints, validateFraction),
 	}
 }
 
@@ -64,37 +130,102 @@ func validateQuorumDenominator(i interface{}) error {
 	return nil
 }
 
-func DefaultParams() Params {
-	return Params{QuorumNumerator: DefaultQuorumNum, QuorumDenominator: DefaultQuorumDen}
+func validateQuorumMode(i interface{}) error {
+	v, ok := i.(QuorumMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := QuorumMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid quorum mode: %d", v)
+	}
+	return nil
+}
+
+func validateSnapshotRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v != 0 && v < MinSnapshotRetention {
+		return fmt.Errorf("snapshot retention must be 0 (keep all) or at least %d: %d", MinSnapshotRetention, v)
+	}
+	return nil
+}
+
+func validateEpochLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("epoch length cannot be zero")
+	}
+	return nil
 }
 
-// ParamsService defines the interface for parameter operations
-type ParamsService interface {
-	Get(ctx context.Context, subspace, key string) ([]byte, error)
-	Set(ctx context.Context, subspace, key string, value []byte) error
-	Has(ctx context.Context, subspace, key string) (bool, error)
-	GetParams(ctx context.Context) (Params, error)
-	SetParams(ctx context.Context, params Params) error
+func validateSignedCheckpointsWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("signed checkpoints window cannot be negative: %d", v)
+	}
+	return nil
 }
 
-// ParamsInputs defines the inputs for parameter operations
-type ParamsInputs struct {
-	depinject.In
-	ParamsService ParamsService
-	AddressCodec  address.Codec
+func validateMaxStorageURILength(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 || v > MaxStorageURILength {
+		return fmt.Errorf("max storage uri length must be between 1 and %d: %d", MaxStorageURILength, v)
+	}
+	return nil
 }
 
-// ParamsOutputs defines the outputs for parameter operations
-type ParamsOutputs struct {
-	depinject.Out
-	Params Params
+func validateKeyRotationDelay(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 || v > MaxKeyRotationDelay {
+		return fmt.Errorf("key rotation delay must be between 1 and %d: %d", MaxKeyRotationDelay, v)
+	}
+	return nil
+}
+
+// validateFraction accepts an unset (nil) decimal, which is treated as zero.
+func validateFraction(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fraction must be between 0 and 1: %s", v)
+	}
+	return nil
 }
 
-// ProvideParams provides the duty module parameters
-func ProvideParams(in ParamsInputs) (ParamsOutputs, error) {
-	// For now, return default params
-	// In a full implementation, you would read from the params service
-	return ParamsOutputs{
-		Params: DefaultParams(),
-	}, nil
+func DefaultParams() Params {
+	return Params{
+		QuorumNumerator:   DefaultQuorumNum,
+		QuorumDenominator: DefaultQuorumDen,
+		SnapshotRetention: DefaultSnapshotRetention,
+		EpochLength:       DefaultEpochLength,
+		QuorumMode:        DefaultQuorumMode,
+
+		SignedCheckpointsWindow:        DefaultSignedCheckpointsWindow,
+		MinSignedPerWindow:             DefaultMinSignedPerWindow,
+		SlashFractionMissedCheckpoints: DefaultSlashFractionMissedCheckpoints,
+
+		MaxStorageUriLength: DefaultMaxStorageURILength,
+		KeyRotationDelay:    DefaultKeyRotationDelay,
+
+		CheckpointSigningGracePeriod: DefaultCheckpointSigningGracePeriod,
+	}
 }
@@ -0,0 +1,753 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: duty/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QuorumMode selects what the quorum fraction is taken of.
+type QuorumMode int32
+
+const (
+	// quorum is a fraction of the duty set's total voting power
+	QuorumMode_QUORUM_MODE_POWER QuorumMode = 0
+	// quorum is a fraction of the number of validators in the duty set, as in
+	// a Hyperlane static multisig ISM
+	QuorumMode_QUORUM_MODE_COUNT QuorumMode = 1
+)
+
+var QuorumMode_name = map[int32]string{
+	0: "QUORUM_MODE_POWER",
+	1: "QUORUM_MODE_COUNT",
+}
+
+var QuorumMode_value = map[string]int32{
+	"QUORUM_MODE_POWER": 0,
+	"QUORUM_MODE_COUNT": 1,
+}
+
+func (x QuorumMode) String() string {
+	return proto.EnumName(QuorumMode_name, int32(x))
+}
+
+func (QuorumMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae5883757f6a9db5, []int{0}
+}
+
+// Params defines the parameters of the duty module.
+type Params struct {
+	QuorumNumerator   uint32 `protobuf:"varint,1,opt,name=quorum_numerator,json=quorumNumerator,proto3" json:"quorum_num" yaml:"quorum_num"`
+	QuorumDenominator uint32 `protobuf:"varint,2,opt,name=quorum_denominator,json=quorumDenominator,proto3" json:"quorum_den" yaml:"quorum_den"`
+	// snapshot_retention is the number of most recent duty set snapshots
+	// (epochs) kept in state; 0 disables pruning.
+	SnapshotRetention uint64 `protobuf:"varint,3,opt,name=snapshot_retention,json=snapshotRetention,proto3" json:"snapshot_retention,omitempty"`
+	// epoch_length is the number of blocks between commits of the pending duty
+	// set; 0 commits every change at the end of the block it happens in.
+	EpochLength uint64 `protobuf:"varint,4,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// signed_checkpoints_window is the number of quorum checkpoints a validator
+	// is expected to sign per liveness window; 0 disables liveness tracking.
+	SignedCheckpointsWindow int64 `protobuf:"varint,5,opt,name=signed_checkpoints_window,json=signedCheckpointsWindow,proto3" json:"signed_checkpoints_window,omitempty"`
+	// min_signed_per_window is the minimum fraction of the window a validator
+	// must sign to avoid being jailed.
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
+	// slash_fraction_missed_checkpoints is slashed when a validator is jailed
+	// for missing checkpoints.
+	SlashFractionMissedCheckpoints cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=slash_fraction_missed_checkpoints,json=slashFractionMissedCheckpoints,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_missed_checkpoints"`
+	// quorum_mode selects whether quorum_num/quorum_den is applied to voting
+	// power or to the number of validators.
+	QuorumMode QuorumMode `protobuf:"varint,8,opt,name=quorum_mode,json=quorumMode,proto3,enum=duty.v1.QuorumMode" json:"quorum_mode,omitempty"`
+	// max_storage_uri_length is the longest checkpoint storage URI, in bytes,
+	// validators may register.
+	MaxStorageUriLength uint32 `protobuf:"varint,9,opt,name=max_storage_uri_length,json=maxStorageUriLength,proto3" json:"max_storage_uri_length,omitempty"`
+	// key_rotation_delay is the number of blocks between an accepted checkpoint
+	// key rotation and its activation. Both keys sign during the delay; zero
+	// activates rotations in the EndBlock of the same block.
+	KeyRotationDelay uint64 `protobuf:"varint,10,opt,name=key_rotation_delay,json=keyRotationDelay,proto3" json:"key_rotation_delay,omitempty"`
+	// checkpoint_signing_grace_period is the number of blocks after a
+	// checkpoint reaches quorum during which signatures still count for
+	// liveness. Signers are recorded in the EndBlock of the last grace block;
+	// zero records them at the end of the block quorum is reached in.
+	CheckpointSigningGracePeriod uint64 `protobuf:"varint,11,opt,name=checkpoint_signing_grace_period,json=checkpointSigningGracePeriod,proto3" json:"checkpoint_signing_grace_period,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5883757f6a9db5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetQuorumNumerator() uint32 {
+	if m != nil {
+		return m.QuorumNumerator
+	}
+	return 0
+}
+
+func (m *Params) GetQuorumDenominator() uint32 {
+	if m != nil {
+		return m.QuorumDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetSnapshotRetention() uint64 {
+	if m != nil {
+		return m.SnapshotRetention
+	}
+	return 0
+}
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetSignedCheckpointsWindow() int64 {
+	if m != nil {
+		return m.SignedCheckpointsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetQuorumMode() QuorumMode {
+	if m != nil {
+		return m.QuorumMode
+	}
+	return QuorumMode_QUORUM_MODE_POWER
+}
+
+func (m *Params) GetMaxStorageUriLength() uint32 {
+	if m != nil {
+		return m.MaxStorageUriLength
+	}
+	return 0
+}
+
+func (m *Params) GetKeyRotationDelay() uint64 {
+	if m != nil {
+		return m.KeyRotationDelay
+	}
+	return 0
+}
+
+func (m *Params) GetCheckpointSigningGracePeriod() uint64 {
+	if m != nil {
+		return m.CheckpointSigningGracePeriod
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("duty.v1.QuorumMode", QuorumMode_name, QuorumMode_value)
+	proto.RegisterType((*Params)(nil), "duty.v1.Params")
+}
+
+func init() { proto.RegisterFile("duty/v1/params.proto", fileDescriptor_ae5883757f6a9db5) }
+
+var fileDescriptor_ae5883757f6a9db5 = []byte{
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcb, 0x52, 0xdb, 0x3e,
+	0x14, 0xc6, 0xe3, 0x3f, 0xb7, 0x3f, 0xa2, 0x97, 0x44, 0x40, 0xeb, 0x5e, 0xc6, 0x09, 0x61, 0x93,
+	0xe9, 0x50, 0x7b, 0x28, 0x5d, 0xb1, 0x2b, 0x04, 0xba, 0x21, 0x24, 0x18, 0x52, 0x66, 0xba, 0xd1,
+	0x08, 0x5b, 0xb5, 0x35, 0x89, 0x24, 0x23, 0xc9, 0x80, 0xdf, 0xa2, 0x8f, 0xc5, 0x92, 0x65, 0xa7,
+	0x8b, 0x4c, 0x27, 0xd9, 0x75, 0xd9, 0x27, 0xe8, 0x58, 0x71, 0xea, 0xb6, 0x6c, 0xba, 0x93, 0xbe,
+	0xdf, 0x77, 0x3e, 0xeb, 0x1c, 0x59, 0x60, 0x2d, 0x4c, 0x75, 0xe6, 0x5d, 0x6d, 0x7b, 0x09, 0x96,
+	0x98, 0x29, 0x37, 0x91, 0x42, 0x0b, 0xb8, 0x94, 0xab, 0xee, 0xd5, 0xf6, 0xf3, 0xb5, 0x48, 0x44,
+	0xc2, 0x68, 0x5e, 0xbe, 0x9a, 0xe2, 0xe6, 0x78, 0x01, 0x2c, 0xf6, 0x8c, 0x1f, 0x1e, 0x83, 0xea,
+	0x65, 0x2a, 0x64, 0xca, 0x10, 0x4f, 0x19, 0x91, 0x58, 0x0b, 0x69, 0x5b, 0x0d, 0xab, 0xf5, 0x70,
+	0x6f, 0xf3, 0xfb, 0xa8, 0x0e, 0x4a, 0xf6, 0x63, 0x54, 0xaf, 0x65, 0x98, 0x0d, 0x77, 0x9b, 0xa5,
+	0xd6, 0xf4, 0x1f, 0x4f, 0x37, 0xc7, 0xb3, 0x5a, 0xe8, 0x03, 0x58, 0xf0, 0x90, 0x70, 0xc1, 0x28,
+	0x37, 0x89, 0xff, 0xdd, 0x4b, 0x0c, 0x09, 0xbf, 0x97, 0x18, 0x12, 0xde, 0xf4, 0x6b, 0xd3, 0x4d,
+	0xbb, 0xac, 0x86, 0xaf, 0x01, 0x54, 0x1c, 0x27, 0x2a, 0x16, 0x1a, 0x49, 0xa2, 0x09, 0xd7, 0x54,
+	0x70, 0x7b, 0xae, 0x61, 0xb5, 0xe6, 0xfd, 0xda, 0x8c, 0xf8, 0x33, 0x00, 0x37, 0xc0, 0x03, 0x92,
+	0x88, 0x20, 0x46, 0x43, 0xc2, 0x23, 0x1d, 0xdb, 0xf3, 0xc6, 0xb8, 0x62, 0xb4, 0x23, 0x23, 0xc1,
+	0x5d, 0xf0, 0x4c, 0xd1, 0x88, 0x93, 0x10, 0x05, 0x31, 0x09, 0x06, 0x89, 0xa0, 0x5c, 0x2b, 0x74,
+	0x4d, 0x79, 0x28, 0xae, 0xed, 0x85, 0x86, 0xd5, 0x9a, 0xf3, 0x9f, 0x4e, 0x0d, 0xfb, 0x25, 0x3f,
+	0x37, 0x18, 0x7e, 0x00, 0xeb, 0x8c, 0x72, 0x54, 0xd4, 0x27, 0x44, 0xce, 0xea, 0x16, 0x1b, 0x56,
+	0x6b, 0x79, 0x6f, 0xf3, 0x76, 0x54, 0xaf, 0x7c, 0x1d, 0xd5, 0x5f, 0x04, 0x42, 0x31, 0xa1, 0x54,
+	0x38, 0x70, 0xa9, 0xf0, 0x18, 0xd6, 0xb1, 0x7b, 0x44, 0x22, 0x1c, 0x64, 0x6d, 0x12, 0xf8, 0x90,
+	0x51, 0x7e, 0x6a, 0x02, 0x7a, 0x44, 0x16, 0xb9, 0x1c, 0x6c, 0xa8, 0x21, 0x56, 0x31, 0xfa, 0x24,
+	0x71, 0x90, 0x37, 0x82, 0x18, 0x55, 0xea, 0xcf, 0x23, 0xda, 0x4b, 0xff, 0xfe, 0x0d, 0xc7, 0xa4,
+	0x1d, 0x16, 0x61, 0x1d, 0x93, 0xf5, 0x5b, 0x37, 0xf0, 0x2d, 0x58, 0x29, 0xe6, 0xce, 0x44, 0x48,
+	0xec, 0xff, 0x1b, 0x56, 0xeb, 0xd1, 0x9b, 0x55, 0xb7, 0xf8, 0x73, 0xdc, 0x13, 0xc3, 0x3a, 0x22,
+	0x24, 0x3e, 0xb8, 0xfc, 0xb5, 0x86, 0x3b, 0xe0, 0x09, 0xc3, 0x37, 0x48, 0x69, 0x21, 0x71, 0x44,
+	0x50, 0x2a, 0xe9, 0x6c, 0xcc, 0xcb, 0xf9, 0x1d, 0xfb, 0xab, 0x0c, 0xdf, 0x9c, 0x4e, 0x61, 0x5f,
+	0xd2, 0x62, 0xdc, 0x5b, 0x00, 0x0e, 0x48, 0x86, 0xa4, 0xd0, 0xd8, 0x34, 0x16, 0x92, 0x21, 0xce,
+	0x6c, 0x60, 0xee, 0xa5, 0x3a, 0x20, 0x99, 0x5f, 0x80, 0x76, 0xae, 0xc3, 0x03, 0x50, 0x2f, 0x5b,
+	0x36, 0x73, 0xa6, 0x3c, 0x42, 0x91, 0xc4, 0x01, 0xc9, 0xc7, 0x4d, 0x45, 0x68, 0xaf, 0x98, 0xd2,
+	0x97, 0xa5, 0xed, 0x74, 0xea, 0x7a, 0x9f, 0x9b, 0x7a, 0xc6, 0xf3, 0x6a, 0x17, 0x80, 0xb2, 0x07,
+	0xb8, 0x0e, 0x6a, 0x27, 0xfd, 0xae, 0xdf, 0xef, 0xa0, 0x4e, 0xb7, 0x7d, 0x80, 0x7a, 0xdd, 0xf3,
+	0x03, 0xbf, 0x5a, 0xf9, 0x5b, 0xde, 0xef, 0xf6, 0x8f, 0xcf, 0xaa, 0xd6, 0xde, 0xe1, 0xed, 0xd8,
+	0xb1, 0xee, 0xc6, 0x8e, 0xf5, 0x6d, 0xec, 0x58, 0x9f, 0x27, 0x4e, 0xe5, 0x6e, 0xe2, 0x54, 0xbe,
+	0x4c, 0x9c, 0xca, 0xc7, 0xad, 0x88, 0xea, 0x38, 0xbd, 0x70, 0x03, 0xc1, 0xbc, 0xb3, 0x98, 0xbc,
+	0x93, 0x9a, 0x06, 0xe9, 0xd0, 0x9c, 0xde, 0x6b, 0xe7, 0x4f, 0xf1, 0xc6, 0x33, 0x2f, 0x52, 0x67,
+	0x09, 0x51, 0x17, 0x8b, 0xe6, 0xbd, 0xed, 0xfc, 0x1c, 0x00, 0x38, 0x3a, 0x4f, 0x31, 0xa6, 0x03,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckpointSigningGracePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CheckpointSigningGracePeriod))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.KeyRotationDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.KeyRotationDelay))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxStorageUriLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStorageUriLength))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.QuorumMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QuorumMode))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.SlashFractionMissedCheckpoints.Size()
+		i -= size
+		if _, err := m.SlashFractionMissedCheckpoints.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.SignedCheckpointsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedCheckpointsWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SnapshotRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SnapshotRetention))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QuorumDenominator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QuorumDenominator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QuorumNumerator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QuorumNumerator))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuorumNumerator != 0 {
+		n += 1 + sovParams(uint64(m.QuorumNumerator))
+	}
+	if m.QuorumDenominator != 0 {
+		n += 1 + sovParams(uint64(m.QuorumDenominator))
+	}
+	if m.SnapshotRetention != 0 {
+		n += 1 + sovParams(uint64(m.SnapshotRetention))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovParams(uint64(m.EpochLength))
+	}
+	if m.SignedCheckpointsWindow != 0 {
+		n += 1 + sovParams(uint64(m.SignedCheckpointsWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFractionMissedCheckpoints.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.QuorumMode != 0 {
+		n += 1 + sovParams(uint64(m.QuorumMode))
+	}
+	if m.MaxStorageUriLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxStorageUriLength))
+	}
+	if m.KeyRotationDelay != 0 {
+		n += 1 + sovParams(uint64(m.KeyRotationDelay))
+	}
+	if m.CheckpointSigningGracePeriod != 0 {
+		n += 1 + sovParams(uint64(m.CheckpointSigningGracePeriod))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumNumerator", wireType)
+			}
+			m.QuorumNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumNumerator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumDenominator", wireType)
+			}
+			m.QuorumDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetention", wireType)
+			}
+			m.SnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedCheckpointsWindow", wireType)
+			}
+			m.SignedCheckpointsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedCheckpointsWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionMissedCheckpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionMissedCheckpoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumMode", wireType)
+			}
+			m.QuorumMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumMode |= QuorumMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStorageUriLength", wireType)
+			}
+			m.MaxStorageUriLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStorageUriLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationDelay", wireType)
+			}
+			m.KeyRotationDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRotationDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointSigningGracePeriod", wireType)
+			}
+			m.CheckpointSigningGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointSigningGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
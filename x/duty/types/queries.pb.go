@@ -0,0 +1,10819 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: duty/v1/queries.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DutySetOrder is the order in which DutySet returns validators.
+type DutySetOrder int32
+
+const (
+	// highest voting power first, the order the set is committed in
+	DutySetOrder_DUTY_SET_ORDER_POWER_DESC DutySetOrder = 0
+	DutySetOrder_DUTY_SET_ORDER_POWER_ASC  DutySetOrder = 1
+	// by bech32 consensus address
+	DutySetOrder_DUTY_SET_ORDER_CONS_ADDR DutySetOrder = 2
+)
+
+var DutySetOrder_name = map[int32]string{
+	0: "DUTY_SET_ORDER_POWER_DESC",
+	1: "DUTY_SET_ORDER_POWER_ASC",
+	2: "DUTY_SET_ORDER_CONS_ADDR",
+}
+
+var DutySetOrder_value = map[string]int32{
+	"DUTY_SET_ORDER_POWER_DESC": 0,
+	"DUTY_SET_ORDER_POWER_ASC":  1,
+	"DUTY_SET_ORDER_CONS_ADDR":  2,
+}
+
+func (x DutySetOrder) String() string {
+	return proto.EnumName(DutySetOrder_name, int32(x))
+}
+
+func (DutySetOrder) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{0}
+}
+
+// QueryDutySetRequest filters and pages the active duty set. Filters are
+// applied before pagination. pagination.key is the consensus address of the
+// first validator to return, as given by next_key in the previous response.
+type QueryDutySetRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only_with_metadata returns only validators with a checkpoint key
+	OnlyWithMetadata bool `protobuf:"varint,2,opt,name=only_with_metadata,json=onlyWithMetadata,proto3" json:"only_with_metadata,omitempty"`
+	// missing_metadata returns only validators without a checkpoint key
+	MissingMetadata bool `protobuf:"varint,3,opt,name=missing_metadata,json=missingMetadata,proto3" json:"missing_metadata,omitempty"`
+	// min_voting_power drops validators below this consensus power (integer)
+	MinVotingPower string       `protobuf:"bytes,4,opt,name=min_voting_power,json=minVotingPower,proto3" json:"min_voting_power,omitempty"`
+	Order          DutySetOrder `protobuf:"varint,5,opt,name=order,proto3,enum=duty.v1.DutySetOrder" json:"order,omitempty"`
+	// origin_domain, if set, resolves each validator's checkpoint key and
+	// storage URI and the quorum for that domain
+	OriginDomain uint32 `protobuf:"varint,6,opt,name=origin_domain,json=originDomain,proto3" json:"origin_domain,omitempty"`
+}
+
+func (m *QueryDutySetRequest) Reset()         { *m = QueryDutySetRequest{} }
+func (m *QueryDutySetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutySetRequest) ProtoMessage()    {}
+func (*QueryDutySetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{0}
+}
+func (m *QueryDutySetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutySetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutySetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutySetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutySetRequest.Merge(m, src)
+}
+func (m *QueryDutySetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutySetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutySetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutySetRequest proto.InternalMessageInfo
+
+func (m *QueryDutySetRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryDutySetRequest) GetOnlyWithMetadata() bool {
+	if m != nil {
+		return m.OnlyWithMetadata
+	}
+	return false
+}
+
+func (m *QueryDutySetRequest) GetMissingMetadata() bool {
+	if m != nil {
+		return m.MissingMetadata
+	}
+	return false
+}
+
+func (m *QueryDutySetRequest) GetMinVotingPower() string {
+	if m != nil {
+		return m.MinVotingPower
+	}
+	return ""
+}
+
+func (m *QueryDutySetRequest) GetOrder() DutySetOrder {
+	if m != nil {
+		return m.Order
+	}
+	return DutySetOrder_DUTY_SET_ORDER_POWER_DESC
+}
+
+func (m *QueryDutySetRequest) GetOriginDomain() uint32 {
+	if m != nil {
+		return m.OriginDomain
+	}
+	return 0
+}
+
+type DutyValidator struct {
+	ValConsAddr string `protobuf:"bytes,1,opt,name=val_cons_addr,json=valConsAddr,proto3" json:"val_cons_addr,omitempty"`
+	// voting_power is the CometBFT consensus power (tokens / power reduction)
+	VotingPower          string `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	CheckpointPubKey     string `protobuf:"bytes,3,opt,name=checkpoint_pub_key,json=checkpointPubKey,proto3" json:"checkpoint_pub_key,omitempty"`
+	CheckpointStorageUri string `protobuf:"bytes,4,opt,name=checkpoint_storage_uri,json=checkpointStorageUri,proto3" json:"checkpoint_storage_uri,omitempty"`
+	// tokens is the validator's bonded stake when the set was computed
+	Tokens string `protobuf:"bytes,5,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	// weight_bps is voting_power as a share of the whole set in basis points;
+	// the weights of a set sum to exactly 10000
+	WeightBps uint32 `protobuf:"varint,6,opt,name=weight_bps,json=weightBps,proto3" json:"weight_bps,omitempty"`
+	// domain_configs are the validator's per-domain key and URI overrides
+	DomainConfigs []*DomainCheckpointConfig `protobuf:"bytes,7,rep,name=domain_configs,json=domainConfigs,proto3" json:"domain_configs,omitempty"`
+}
+
+func (m *DutyValidator) Reset()         { *m = DutyValidator{} }
+func (m *DutyValidator) String() string { return proto.CompactTextString(m) }
+func (*DutyValidator) ProtoMessage()    {}
+func (*DutyValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{1}
+}
+func (m *DutyValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutyValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutyValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutyValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutyValidator.Merge(m, src)
+}
+func (m *DutyValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutyValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutyValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutyValidator proto.InternalMessageInfo
+
+func (m *DutyValidator) GetValConsAddr() string {
+	if m != nil {
+		return m.ValConsAddr
+	}
+	return ""
+}
+
+func (m *DutyValidator) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
+	}
+	return ""
+}
+
+func (m *DutyValidator) GetCheckpointPubKey() string {
+	if m != nil {
+		return m.CheckpointPubKey
+	}
+	return ""
+}
+
+func (m *DutyValidator) GetCheckpointStorageUri() string {
+	if m != nil {
+		return m.CheckpointStorageUri
+	}
+	return ""
+}
+
+func (m *DutyValidator) GetTokens() string {
+	if m != nil {
+		return m.Tokens
+	}
+	return ""
+}
+
+func (m *DutyValidator) GetWeightBps() uint32 {
+	if m != nil {
+		return m.WeightBps
+	}
+	return 0
+}
+
+func (m *DutyValidator) GetDomainConfigs() []*DomainCheckpointConfig {
+	if m != nil {
+		return m.DomainConfigs
+	}
+	return nil
+}
+
+// QueryDutySetResponse is the active duty set committed for the current epoch.
+type QueryDutySetResponse struct {
+	Validators []*DutyValidator    `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	QuorumNum  uint32              `protobuf:"varint,2,opt,name=quorum_num,json=quorumNum,proto3" json:"quorum_num,omitempty"`
+	QuorumDen  uint32              `protobuf:"varint,3,opt,name=quorum_den,json=quorumDen,proto3" json:"quorum_den,omitempty"`
+	Epoch      uint64              `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height     int64               `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	QuorumMode QuorumMode          `protobuf:"varint,7,opt,name=quorum_mode,json=quorumMode,proto3,enum=duty.v1.QuorumMode" json:"quorum_mode,omitempty"`
+	// quorum_threshold is the number of signatures needed in count mode (the
+	// threshold of a static multisig ISM over the whole set), or the least
+	// signed voting power in power mode. Both round up; filters and paging do
+	// not change it.
+	QuorumThreshold string `protobuf:"bytes,8,opt,name=quorum_threshold,json=quorumThreshold,proto3" json:"quorum_threshold,omitempty"`
+}
+
+func (m *QueryDutySetResponse) Reset()         { *m = QueryDutySetResponse{} }
+func (m *QueryDutySetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDutySetResponse) ProtoMessage()    {}
+func (*QueryDutySetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{2}
+}
+func (m *QueryDutySetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutySetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutySetResponse.Merge(m, src)
+}
+func (m *QueryDutySetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutySetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutySetResponse proto.InternalMessageInfo
+
+func (m *QueryDutySetResponse) GetValidators() []*DutyValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *QueryDutySetResponse) GetQuorumNum() uint32 {
+	if m != nil {
+		return m.QuorumNum
+	}
+	return 0
+}
+
+func (m *QueryDutySetResponse) GetQuorumDen() uint32 {
+	if m != nil {
+		return m.QuorumDen
+	}
+	return 0
+}
+
+func (m *QueryDutySetResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryDutySetResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryDutySetResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryDutySetResponse) GetQuorumMode() QuorumMode {
+	if m != nil {
+		return m.QuorumMode
+	}
+	return QuorumMode_QUORUM_MODE_POWER
+}
+
+func (m *QueryDutySetResponse) GetQuorumThreshold() string {
+	if m != nil {
+		return m.QuorumThreshold
+	}
+	return ""
+}
+
+// QueryPendingDutySetResponse is the duty set that will become active at
+// next_epoch_height if staking does not change again before then.
+type QueryPendingDutySetRequest struct {
+}
+
+func (m *QueryPendingDutySetRequest) Reset()         { *m = QueryPendingDutySetRequest{} }
+func (m *QueryPendingDutySetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDutySetRequest) ProtoMessage()    {}
+func (*QueryPendingDutySetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{3}
+}
+func (m *QueryPendingDutySetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDutySetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDutySetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingDutySetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDutySetRequest.Merge(m, src)
+}
+func (m *QueryPendingDutySetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDutySetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDutySetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingDutySetRequest proto.InternalMessageInfo
+
+type QueryPendingDutySetResponse struct {
+	Validators      []*DutyValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	QuorumNum       uint32           `protobuf:"varint,2,opt,name=quorum_num,json=quorumNum,proto3" json:"quorum_num,omitempty"`
+	QuorumDen       uint32           `protobuf:"varint,3,opt,name=quorum_den,json=quorumDen,proto3" json:"quorum_den,omitempty"`
+	NextEpochHeight int64            `protobuf:"varint,4,opt,name=next_epoch_height,json=nextEpochHeight,proto3" json:"next_epoch_height,omitempty"`
+	QuorumMode      QuorumMode       `protobuf:"varint,5,opt,name=quorum_mode,json=quorumMode,proto3,enum=duty.v1.QuorumMode" json:"quorum_mode,omitempty"`
+	// quorum_threshold is computed as in QueryDutySetResponse
+	QuorumThreshold string `protobuf:"bytes,6,opt,name=quorum_threshold,json=quorumThreshold,proto3" json:"quorum_threshold,omitempty"`
+}
+
+func (m *QueryPendingDutySetResponse) Reset()         { *m = QueryPendingDutySetResponse{} }
+func (m *QueryPendingDutySetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDutySetResponse) ProtoMessage()    {}
+func (*QueryPendingDutySetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{4}
+}
+func (m *QueryPendingDutySetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDutySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDutySetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingDutySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDutySetResponse.Merge(m, src)
+}
+func (m *QueryPendingDutySetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDutySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDutySetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingDutySetResponse proto.InternalMessageInfo
+
+func (m *QueryPendingDutySetResponse) GetValidators() []*DutyValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *QueryPendingDutySetResponse) GetQuorumNum() uint32 {
+	if m != nil {
+		return m.QuorumNum
+	}
+	return 0
+}
+
+func (m *QueryPendingDutySetResponse) GetQuorumDen() uint32 {
+	if m != nil {
+		return m.QuorumDen
+	}
+	return 0
+}
+
+func (m *QueryPendingDutySetResponse) GetNextEpochHeight() int64 {
+	if m != nil {
+		return m.NextEpochHeight
+	}
+	return 0
+}
+
+func (m *QueryPendingDutySetResponse) GetQuorumMode() QuorumMode {
+	if m != nil {
+		return m.QuorumMode
+	}
+	return QuorumMode_QUORUM_MODE_POWER
+}
+
+func (m *QueryPendingDutySetResponse) GetQuorumThreshold() string {
+	if m != nil {
+		return m.QuorumThreshold
+	}
+	return ""
+}
+
+type QueryDutyMetadataRequest struct {
+	ConsAddr string `protobuf:"bytes,1,opt,name=cons_addr,json=consAddr,proto3" json:"cons_addr,omitempty"`
+}
+
+func (m *QueryDutyMetadataRequest) Reset()         { *m = QueryDutyMetadataRequest{} }
+func (m *QueryDutyMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutyMetadataRequest) ProtoMessage()    {}
+func (*QueryDutyMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{5}
+}
+func (m *QueryDutyMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutyMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutyMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutyMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutyMetadataRequest.Merge(m, src)
+}
+func (m *QueryDutyMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutyMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutyMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutyMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryDutyMetadataRequest) GetConsAddr() string {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return ""
+}
+
+type QueryDutyMetadataResponse struct {
+	Metadata *DutyMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *QueryDutyMetadataResponse) Reset()         { *m = QueryDutyMetadataResponse{} }
+func (m *QueryDutyMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDutyMetadataResponse) ProtoMessage()    {}
+func (*QueryDutyMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{6}
+}
+func (m *QueryDutyMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutyMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutyMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutyMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutyMetadataResponse.Merge(m, src)
+}
+func (m *QueryDutyMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutyMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutyMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutyMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryDutyMetadataResponse) GetMetadata() *DutyMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// DutySetSnapshot is the duty set as committed at a given height. A new
+// snapshot (and epoch) is written whenever the set hash changes.
+type DutySetSnapshot struct {
+	Epoch      uint64           `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height     int64            `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Validators []*DutyValidator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	QuorumNum  uint32           `protobuf:"varint,4,opt,name=quorum_num,json=quorumNum,proto3" json:"quorum_num,omitempty"`
+	QuorumDen  uint32           `protobuf:"varint,5,opt,name=quorum_den,json=quorumDen,proto3" json:"quorum_den,omitempty"`
+	SetHash    []byte           `protobuf:"bytes,6,opt,name=set_hash,json=setHash,proto3" json:"set_hash,omitempty"`
+	QuorumMode QuorumMode       `protobuf:"varint,7,opt,name=quorum_mode,json=quorumMode,proto3,enum=duty.v1.QuorumMode" json:"quorum_mode,omitempty"`
+}
+
+func (m *DutySetSnapshot) Reset()         { *m = DutySetSnapshot{} }
+func (m *DutySetSnapshot) String() string { return proto.CompactTextString(m) }
+func (*DutySetSnapshot) ProtoMessage()    {}
+func (*DutySetSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{7}
+}
+func (m *DutySetSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutySetSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutySetSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutySetSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutySetSnapshot.Merge(m, src)
+}
+func (m *DutySetSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutySetSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutySetSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutySetSnapshot proto.InternalMessageInfo
+
+func (m *DutySetSnapshot) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *DutySetSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DutySetSnapshot) GetValidators() []*DutyValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *DutySetSnapshot) GetQuorumNum() uint32 {
+	if m != nil {
+		return m.QuorumNum
+	}
+	return 0
+}
+
+func (m *DutySetSnapshot) GetQuorumDen() uint32 {
+	if m != nil {
+		return m.QuorumDen
+	}
+	return 0
+}
+
+func (m *DutySetSnapshot) GetSetHash() []byte {
+	if m != nil {
+		return m.SetHash
+	}
+	return nil
+}
+
+func (m *DutySetSnapshot) GetQuorumMode() QuorumMode {
+	if m != nil {
+		return m.QuorumMode
+	}
+	return QuorumMode_QUORUM_MODE_POWER
+}
+
+type QueryDutySetAtHeightRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryDutySetAtHeightRequest) Reset()         { *m = QueryDutySetAtHeightRequest{} }
+func (m *QueryDutySetAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutySetAtHeightRequest) ProtoMessage()    {}
+func (*QueryDutySetAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{8}
+}
+func (m *QueryDutySetAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutySetAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutySetAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutySetAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutySetAtHeightRequest.Merge(m, src)
+}
+func (m *QueryDutySetAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutySetAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutySetAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutySetAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryDutySetAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QueryDutySetAtHeightResponse struct {
+	Snapshot *DutySetSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// quorum_threshold is computed as in QueryDutySetResponse
+	QuorumThreshold string `protobuf:"bytes,2,opt,name=quorum_threshold,json=quorumThreshold,proto3" json:"quorum_threshold,omitempty"`
+}
+
+func (m *QueryDutySetAtHeightResponse) Reset()         { *m = QueryDutySetAtHeightResponse{} }
+func (m *QueryDutySetAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDutySetAtHeightResponse) ProtoMessage()    {}
+func (*QueryDutySetAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{9}
+}
+func (m *QueryDutySetAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutySetAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutySetAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutySetAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutySetAtHeightResponse.Merge(m, src)
+}
+func (m *QueryDutySetAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutySetAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutySetAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutySetAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryDutySetAtHeightResponse) GetSnapshot() *DutySetSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+func (m *QueryDutySetAtHeightResponse) GetQuorumThreshold() string {
+	if m != nil {
+		return m.QuorumThreshold
+	}
+	return ""
+}
+
+type QueryDutySetByEpochRequest struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *QueryDutySetByEpochRequest) Reset()         { *m = QueryDutySetByEpochRequest{} }
+func (m *QueryDutySetByEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutySetByEpochRequest) ProtoMessage()    {}
+func (*QueryDutySetByEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{10}
+}
+func (m *QueryDutySetByEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutySetByEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutySetByEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutySetByEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutySetByEpochRequest.Merge(m, src)
+}
+func (m *QueryDutySetByEpochRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutySetByEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutySetByEpochRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutySetByEpochRequest proto.InternalMessageInfo
+
+func (m *QueryDutySetByEpochRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type QueryDutySetByEpochResponse struct {
+	Snapshot *DutySetSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// quorum_threshold is computed as in QueryDutySetResponse
+	QuorumThreshold string `protobuf:"bytes,2,opt,name=quorum_threshold,json=quorumThreshold,proto3" json:"quorum_threshold,omitempty"`
+}
+
+func (m *QueryDutySetByEpochResponse) Reset()         { *m = QueryDutySetByEpochResponse{} }
+func (m *QueryDutySetByEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDutySetByEpochResponse) ProtoMessage()    {}
+func (*QueryDutySetByEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{11}
+}
+func (m *QueryDutySetByEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutySetByEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutySetByEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutySetByEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutySetByEpochResponse.Merge(m, src)
+}
+func (m *QueryDutySetByEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutySetByEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutySetByEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutySetByEpochResponse proto.InternalMessageInfo
+
+func (m *QueryDutySetByEpochResponse) GetSnapshot() *DutySetSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+func (m *QueryDutySetByEpochResponse) GetQuorumThreshold() string {
+	if m != nil {
+		return m.QuorumThreshold
+	}
+	return ""
+}
+
+type QueryCheckpointRequest struct {
+	OriginDomain uint32 `protobuf:"varint,1,opt,name=origin_domain,json=originDomain,proto3" json:"origin_domain,omitempty"`
+	Index        uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryCheckpointRequest) Reset()         { *m = QueryCheckpointRequest{} }
+func (m *QueryCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointRequest) ProtoMessage()    {}
+func (*QueryCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{12}
+}
+func (m *QueryCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointRequest.Merge(m, src)
+}
+func (m *QueryCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointRequest) GetOriginDomain() uint32 {
+	if m != nil {
+		return m.OriginDomain
+	}
+	return 0
+}
+
+func (m *QueryCheckpointRequest) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// checkpoints holds one entry per distinct root/message ID signed at index
+type QueryCheckpointResponse struct {
+	Checkpoints []*Checkpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+}
+
+func (m *QueryCheckpointResponse) Reset()         { *m = QueryCheckpointResponse{} }
+func (m *QueryCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointResponse) ProtoMessage()    {}
+func (*QueryCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{13}
+}
+func (m *QueryCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointResponse.Merge(m, src)
+}
+func (m *QueryCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointResponse) GetCheckpoints() []*Checkpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+// checkpoint_key is either a checkpoint public key or its 20-byte address (hex)
+type QueryDutyValidatorByCheckpointKeyRequest struct {
+	CheckpointKey string `protobuf:"bytes,1,opt,name=checkpoint_key,json=checkpointKey,proto3" json:"checkpoint_key,omitempty"`
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyRequest) Reset() {
+	*m = QueryDutyValidatorByCheckpointKeyRequest{}
+}
+func (m *QueryDutyValidatorByCheckpointKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutyValidatorByCheckpointKeyRequest) ProtoMessage()    {}
+func (*QueryDutyValidatorByCheckpointKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{14}
+}
+func (m *QueryDutyValidatorByCheckpointKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutyValidatorByCheckpointKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutyValidatorByCheckpointKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutyValidatorByCheckpointKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutyValidatorByCheckpointKeyRequest.Merge(m, src)
+}
+func (m *QueryDutyValidatorByCheckpointKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutyValidatorByCheckpointKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutyValidatorByCheckpointKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutyValidatorByCheckpointKeyRequest proto.InternalMessageInfo
+
+func (m *QueryDutyValidatorByCheckpointKeyRequest) GetCheckpointKey() string {
+	if m != nil {
+		return m.CheckpointKey
+	}
+	return ""
+}
+
+type QueryDutyValidatorByCheckpointKeyResponse struct {
+	ConsAddr string        `protobuf:"bytes,1,opt,name=cons_addr,json=consAddr,proto3" json:"cons_addr,omitempty"`
+	Metadata *DutyMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyResponse) Reset() {
+	*m = QueryDutyValidatorByCheckpointKeyResponse{}
+}
+func (m *QueryDutyValidatorByCheckpointKeyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDutyValidatorByCheckpointKeyResponse) ProtoMessage() {}
+func (*QueryDutyValidatorByCheckpointKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{15}
+}
+func (m *QueryDutyValidatorByCheckpointKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutyValidatorByCheckpointKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutyValidatorByCheckpointKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutyValidatorByCheckpointKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutyValidatorByCheckpointKeyResponse.Merge(m, src)
+}
+func (m *QueryDutyValidatorByCheckpointKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutyValidatorByCheckpointKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutyValidatorByCheckpointKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutyValidatorByCheckpointKeyResponse proto.InternalMessageInfo
+
+func (m *QueryDutyValidatorByCheckpointKeyResponse) GetConsAddr() string {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return ""
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyResponse) GetMetadata() *DutyMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// QueryDutyMetadataByOperatorRequest asks for the metadata of the validator
+// with the given operator address (valoper).
+type QueryDutyMetadataByOperatorRequest struct {
+	ValAddr string `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+}
+
+func (m *QueryDutyMetadataByOperatorRequest) Reset()         { *m = QueryDutyMetadataByOperatorRequest{} }
+func (m *QueryDutyMetadataByOperatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutyMetadataByOperatorRequest) ProtoMessage()    {}
+func (*QueryDutyMetadataByOperatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{16}
+}
+func (m *QueryDutyMetadataByOperatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutyMetadataByOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutyMetadataByOperatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutyMetadataByOperatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutyMetadataByOperatorRequest.Merge(m, src)
+}
+func (m *QueryDutyMetadataByOperatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutyMetadataByOperatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutyMetadataByOperatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutyMetadataByOperatorRequest proto.InternalMessageInfo
+
+func (m *QueryDutyMetadataByOperatorRequest) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
+}
+
+type QueryDutyMetadataByOperatorResponse struct {
+	ConsAddr string        `protobuf:"bytes,1,opt,name=cons_addr,json=consAddr,proto3" json:"cons_addr,omitempty"`
+	Metadata *DutyMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *QueryDutyMetadataByOperatorResponse) Reset()         { *m = QueryDutyMetadataByOperatorResponse{} }
+func (m *QueryDutyMetadataByOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDutyMetadataByOperatorResponse) ProtoMessage()    {}
+func (*QueryDutyMetadataByOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{17}
+}
+func (m *QueryDutyMetadataByOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutyMetadataByOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutyMetadataByOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutyMetadataByOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutyMetadataByOperatorResponse.Merge(m, src)
+}
+func (m *QueryDutyMetadataByOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutyMetadataByOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutyMetadataByOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutyMetadataByOperatorResponse proto.InternalMessageInfo
+
+func (m *QueryDutyMetadataByOperatorResponse) GetConsAddr() string {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return ""
+}
+
+func (m *QueryDutyMetadataByOperatorResponse) GetMetadata() *DutyMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// QueryDutyValidatorRequest asks for a validator's staking and duty state.
+// address is either its operator (valoper) or consensus (valcons) address.
+type QueryDutyValidatorRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryDutyValidatorRequest) Reset()         { *m = QueryDutyValidatorRequest{} }
+func (m *QueryDutyValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutyValidatorRequest) ProtoMessage()    {}
+func (*QueryDutyValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{18}
+}
+func (m *QueryDutyValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutyValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutyValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutyValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutyValidatorRequest.Merge(m, src)
+}
+func (m *QueryDutyValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutyValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutyValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutyValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryDutyValidatorRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryDutyValidatorResponse struct {
+	ValAddr  string `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+	ConsAddr string `protobuf:"bytes,2,opt,name=cons_addr,json=consAddr,proto3" json:"cons_addr,omitempty"`
+	Moniker  string `protobuf:"bytes,3,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// status is one of bonded, unbonding, unbonded or jailed
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// voting_power is the CometBFT consensus power (tokens / power reduction)
+	VotingPower string        `protobuf:"bytes,5,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	Tokens      string        `protobuf:"bytes,6,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	Metadata    *DutyMetadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *QueryDutyValidatorResponse) Reset()         { *m = QueryDutyValidatorResponse{} }
+func (m *QueryDutyValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDutyValidatorResponse) ProtoMessage()    {}
+func (*QueryDutyValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{19}
+}
+func (m *QueryDutyValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutyValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutyValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutyValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutyValidatorResponse.Merge(m, src)
+}
+func (m *QueryDutyValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutyValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutyValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutyValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryDutyValidatorResponse) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
+}
+
+func (m *QueryDutyValidatorResponse) GetConsAddr() string {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return ""
+}
+
+func (m *QueryDutyValidatorResponse) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *QueryDutyValidatorResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryDutyValidatorResponse) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
+	}
+	return ""
+}
+
+func (m *QueryDutyValidatorResponse) GetTokens() string {
+	if m != nil {
+		return m.Tokens
+	}
+	return ""
+}
+
+func (m *QueryDutyValidatorResponse) GetMetadata() *DutyMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// DutySetHealth reports how much of the live bonded power has usable duty
+// metadata, i.e. a checkpoint key that parses and a storage URI.
+type DutySetHealth struct {
+	TotalPower         string `protobuf:"bytes,1,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	ValidMetadataPower string `protobuf:"bytes,2,opt,name=valid_metadata_power,json=validMetadataPower,proto3" json:"valid_metadata_power,omitempty"`
+	// missing_metadata_power is held by validators that never registered
+	MissingMetadataPower string `protobuf:"bytes,3,opt,name=missing_metadata_power,json=missingMetadataPower,proto3" json:"missing_metadata_power,omitempty"`
+	// invalid_metadata_power is held by validators whose key does not parse or
+	// whose storage URI is empty
+	InvalidMetadataPower string `protobuf:"bytes,4,opt,name=invalid_metadata_power,json=invalidMetadataPower,proto3" json:"invalid_metadata_power,omitempty"`
+	// quorum_threshold_power is the least power that reaches quorum
+	QuorumThresholdPower string `protobuf:"bytes,5,opt,name=quorum_threshold_power,json=quorumThresholdPower,proto3" json:"quorum_threshold_power,omitempty"`
+	// quorum_achievable is true if valid_metadata_power >= quorum_threshold_power
+	// in power mode, or valid_metadata_count >= quorum_threshold_count in count
+	// mode
+	QuorumAchievable   bool       `protobuf:"varint,6,opt,name=quorum_achievable,json=quorumAchievable,proto3" json:"quorum_achievable,omitempty"`
+	ValidatorCount     uint32     `protobuf:"varint,7,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"`
+	ValidMetadataCount uint32     `protobuf:"varint,8,opt,name=valid_metadata_count,json=validMetadataCount,proto3" json:"valid_metadata_count,omitempty"`
+	QuorumNum          uint32     `protobuf:"varint,9,opt,name=quorum_num,json=quorumNum,proto3" json:"quorum_num,omitempty"`
+	QuorumDen          uint32     `protobuf:"varint,10,opt,name=quorum_den,json=quorumDen,proto3" json:"quorum_den,omitempty"`
+	QuorumMode         QuorumMode `protobuf:"varint,11,opt,name=quorum_mode,json=quorumMode,proto3,enum=duty.v1.QuorumMode" json:"quorum_mode,omitempty"`
+	// quorum_threshold_count is the least number of validators that reaches
+	// quorum
+	QuorumThresholdCount uint32 `protobuf:"varint,12,opt,name=quorum_threshold_count,json=quorumThresholdCount,proto3" json:"quorum_threshold_count,omitempty"`
+}
+
+func (m *DutySetHealth) Reset()         { *m = DutySetHealth{} }
+func (m *DutySetHealth) String() string { return proto.CompactTextString(m) }
+func (*DutySetHealth) ProtoMessage()    {}
+func (*DutySetHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{20}
+}
+func (m *DutySetHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutySetHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutySetHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutySetHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutySetHealth.Merge(m, src)
+}
+func (m *DutySetHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutySetHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutySetHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutySetHealth proto.InternalMessageInfo
+
+func (m *DutySetHealth) GetTotalPower() string {
+	if m != nil {
+		return m.TotalPower
+	}
+	return ""
+}
+
+func (m *DutySetHealth) GetValidMetadataPower() string {
+	if m != nil {
+		return m.ValidMetadataPower
+	}
+	return ""
+}
+
+func (m *DutySetHealth) GetMissingMetadataPower() string {
+	if m != nil {
+		return m.MissingMetadataPower
+	}
+	return ""
+}
+
+func (m *DutySetHealth) GetInvalidMetadataPower() string {
+	if m != nil {
+		return m.InvalidMetadataPower
+	}
+	return ""
+}
+
+func (m *DutySetHealth) GetQuorumThresholdPower() string {
+	if m != nil {
+		return m.QuorumThresholdPower
+	}
+	return ""
+}
+
+func (m *DutySetHealth) GetQuorumAchievable() bool {
+	if m != nil {
+		return m.QuorumAchievable
+	}
+	return false
+}
+
+func (m *DutySetHealth) GetValidatorCount() uint32 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
+}
+
+func (m *DutySetHealth) GetValidMetadataCount() uint32 {
+	if m != nil {
+		return m.ValidMetadataCount
+	}
+	return 0
+}
+
+func (m *DutySetHealth) GetQuorumNum() uint32 {
+	if m != nil {
+		return m.QuorumNum
+	}
+	return 0
+}
+
+func (m *DutySetHealth) GetQuorumDen() uint32 {
+	if m != nil {
+		return m.QuorumDen
+	}
+	return 0
+}
+
+func (m *DutySetHealth) GetQuorumMode() QuorumMode {
+	if m != nil {
+		return m.QuorumMode
+	}
+	return QuorumMode_QUORUM_MODE_POWER
+}
+
+func (m *DutySetHealth) GetQuorumThresholdCount() uint32 {
+	if m != nil {
+		return m.QuorumThresholdCount
+	}
+	return 0
+}
+
+type QueryDutySetHealthRequest struct {
+}
+
+func (m *QueryDutySetHealthRequest) Reset()         { *m = QueryDutySetHealthRequest{} }
+func (m *QueryDutySetHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutySetHealthRequest) ProtoMessage()    {}
+func (*QueryDutySetHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{21}
+}
+func (m *QueryDutySetHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutySetHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutySetHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutySetHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutySetHealthRequest.Merge(m, src)
+}
+func (m *QueryDutySetHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutySetHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutySetHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutySetHealthRequest proto.InternalMessageInfo
+
+type QueryDutySetHealthResponse struct {
+	Health *DutySetHealth `protobuf:"bytes,1,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (m *QueryDutySetHealthResponse) Reset()         { *m = QueryDutySetHealthResponse{} }
+func (m *QueryDutySetHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDutySetHealthResponse) ProtoMessage()    {}
+func (*QueryDutySetHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{22}
+}
+func (m *QueryDutySetHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutySetHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutySetHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutySetHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutySetHealthResponse.Merge(m, src)
+}
+func (m *QueryDutySetHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutySetHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutySetHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutySetHealthResponse proto.InternalMessageInfo
+
+func (m *QueryDutySetHealthResponse) GetHealth() *DutySetHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+// QueryArchivedDutyMetadataRequest asks for the tombstone of a removed
+// validator.
+type QueryArchivedDutyMetadataRequest struct {
+	ConsAddr string `protobuf:"bytes,1,opt,name=cons_addr,json=consAddr,proto3" json:"cons_addr,omitempty"`
+}
+
+func (m *QueryArchivedDutyMetadataRequest) Reset()         { *m = QueryArchivedDutyMetadataRequest{} }
+func (m *QueryArchivedDutyMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedDutyMetadataRequest) ProtoMessage()    {}
+func (*QueryArchivedDutyMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{23}
+}
+func (m *QueryArchivedDutyMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedDutyMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedDutyMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedDutyMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedDutyMetadataRequest.Merge(m, src)
+}
+func (m *QueryArchivedDutyMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedDutyMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedDutyMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedDutyMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryArchivedDutyMetadataRequest) GetConsAddr() string {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return ""
+}
+
+type QueryArchivedDutyMetadataResponse struct {
+	Tombstone *DutyMetadataTombstone `protobuf:"bytes,1,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+}
+
+func (m *QueryArchivedDutyMetadataResponse) Reset()         { *m = QueryArchivedDutyMetadataResponse{} }
+func (m *QueryArchivedDutyMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedDutyMetadataResponse) ProtoMessage()    {}
+func (*QueryArchivedDutyMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{24}
+}
+func (m *QueryArchivedDutyMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedDutyMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedDutyMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedDutyMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedDutyMetadataResponse.Merge(m, src)
+}
+func (m *QueryArchivedDutyMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedDutyMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedDutyMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedDutyMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryArchivedDutyMetadataResponse) GetTombstone() *DutyMetadataTombstone {
+	if m != nil {
+		return m.Tombstone
+	}
+	return nil
+}
+
+// QueryArchivedDutyMetadataByCheckpointKeyRequest asks for the tombstones of
+// removed validators that held a checkpoint key, given as a public key or
+// 20-byte address.
+type QueryArchivedDutyMetadataByCheckpointKeyRequest struct {
+	CheckpointKey string `protobuf:"bytes,1,opt,name=checkpoint_key,json=checkpointKey,proto3" json:"checkpoint_key,omitempty"`
+}
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) Reset() {
+	*m = QueryArchivedDutyMetadataByCheckpointKeyRequest{}
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryArchivedDutyMetadataByCheckpointKeyRequest) ProtoMessage() {}
+func (*QueryArchivedDutyMetadataByCheckpointKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{25}
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedDutyMetadataByCheckpointKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedDutyMetadataByCheckpointKeyRequest.Merge(m, src)
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedDutyMetadataByCheckpointKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedDutyMetadataByCheckpointKeyRequest proto.InternalMessageInfo
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) GetCheckpointKey() string {
+	if m != nil {
+		return m.CheckpointKey
+	}
+	return ""
+}
+
+type QueryArchivedDutyMetadataByCheckpointKeyResponse struct {
+	Tombstones []*DutyMetadataTombstone `protobuf:"bytes,1,rep,name=tombstones,proto3" json:"tombstones,omitempty"`
+}
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) Reset() {
+	*m = QueryArchivedDutyMetadataByCheckpointKeyResponse{}
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryArchivedDutyMetadataByCheckpointKeyResponse) ProtoMessage() {}
+func (*QueryArchivedDutyMetadataByCheckpointKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{26}
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedDutyMetadataByCheckpointKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedDutyMetadataByCheckpointKeyResponse.Merge(m, src)
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedDutyMetadataByCheckpointKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedDutyMetadataByCheckpointKeyResponse proto.InternalMessageInfo
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) GetTombstones() []*DutyMetadataTombstone {
+	if m != nil {
+		return m.Tombstones
+	}
+	return nil
+}
+
+// QueryConsensusKeyMigrationRequest asks how a consensus address relates to
+// consensus key rotations of its validator.
+type QueryConsensusKeyMigrationRequest struct {
+	ConsAddr string `protobuf:"bytes,1,opt,name=cons_addr,json=consAddr,proto3" json:"cons_addr,omitempty"`
+}
+
+func (m *QueryConsensusKeyMigrationRequest) Reset()         { *m = QueryConsensusKeyMigrationRequest{} }
+func (m *QueryConsensusKeyMigrationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusKeyMigrationRequest) ProtoMessage()    {}
+func (*QueryConsensusKeyMigrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{27}
+}
+func (m *QueryConsensusKeyMigrationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusKeyMigrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusKeyMigrationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusKeyMigrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusKeyMigrationRequest.Merge(m, src)
+}
+func (m *QueryConsensusKeyMigrationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusKeyMigrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusKeyMigrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusKeyMigrationRequest proto.InternalMessageInfo
+
+func (m *QueryConsensusKeyMigrationRequest) GetConsAddr() string {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return ""
+}
+
+// QueryConsensusKeyMigrationResponse holds the migration away from the
+// address and the one that led to it, each unset if there is none, and the
+// validator's current consensus address.
+type QueryConsensusKeyMigrationResponse struct {
+	MigratedTo      *ConsensusKeyMigration `protobuf:"bytes,1,opt,name=migrated_to,json=migratedTo,proto3" json:"migrated_to,omitempty"`
+	MigratedFrom    *ConsensusKeyMigration `protobuf:"bytes,2,opt,name=migrated_from,json=migratedFrom,proto3" json:"migrated_from,omitempty"`
+	CurrentConsAddr string                 `protobuf:"bytes,3,opt,name=current_cons_addr,json=currentConsAddr,proto3" json:"current_cons_addr,omitempty"`
+}
+
+func (m *QueryConsensusKeyMigrationResponse) Reset()         { *m = QueryConsensusKeyMigrationResponse{} }
+func (m *QueryConsensusKeyMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusKeyMigrationResponse) ProtoMessage()    {}
+func (*QueryConsensusKeyMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{28}
+}
+func (m *QueryConsensusKeyMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusKeyMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusKeyMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusKeyMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusKeyMigrationResponse.Merge(m, src)
+}
+func (m *QueryConsensusKeyMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusKeyMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusKeyMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusKeyMigrationResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusKeyMigrationResponse) GetMigratedTo() *ConsensusKeyMigration {
+	if m != nil {
+		return m.MigratedTo
+	}
+	return nil
+}
+
+func (m *QueryConsensusKeyMigrationResponse) GetMigratedFrom() *ConsensusKeyMigration {
+	if m != nil {
+		return m.MigratedFrom
+	}
+	return nil
+}
+
+func (m *QueryConsensusKeyMigrationResponse) GetCurrentConsAddr() string {
+	if m != nil {
+		return m.CurrentConsAddr
+	}
+	return ""
+}
+
+// QueryDutyNonceRequest asks for the duty nonce the validator's next
+// MsgSetDutyMetadata, MsgRotateCheckpointKey or MsgBindCheckpointKey must
+// carry and sign.
+type QueryDutyNonceRequest struct {
+	ConsAddr string `protobuf:"bytes,1,opt,name=cons_addr,json=consAddr,proto3" json:"cons_addr,omitempty"`
+}
+
+func (m *QueryDutyNonceRequest) Reset()         { *m = QueryDutyNonceRequest{} }
+func (m *QueryDutyNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutyNonceRequest) ProtoMessage()    {}
+func (*QueryDutyNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{29}
+}
+func (m *QueryDutyNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutyNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutyNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutyNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutyNonceRequest.Merge(m, src)
+}
+func (m *QueryDutyNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutyNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutyNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutyNonceRequest proto.InternalMessageInfo
+
+func (m *QueryDutyNonceRequest) GetConsAddr() string {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return ""
+}
+
+type QueryDutyNonceResponse struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryDutyNonceResponse) Reset()         { *m = QueryDutyNonceResponse{} }
+func (m *QueryDutyNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDutyNonceResponse) ProtoMessage()    {}
+func (*QueryDutyNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{30}
+}
+func (m *QueryDutyNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutyNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutyNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDutyNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutyNonceResponse.Merge(m, src)
+}
+func (m *QueryDutyNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutyNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutyNonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutyNonceResponse proto.InternalMessageInfo
+
+func (m *QueryDutyNonceResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// QueryCheckpointKeysRequest asks for a validator's checkpoint key rotation
+// state.
+type QueryCheckpointKeysRequest struct {
+	ConsAddr string `protobuf:"bytes,1,opt,name=cons_addr,json=consAddr,proto3" json:"cons_addr,omitempty"`
+}
+
+func (m *QueryCheckpointKeysRequest) Reset()         { *m = QueryCheckpointKeysRequest{} }
+func (m *QueryCheckpointKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointKeysRequest) ProtoMessage()    {}
+func (*QueryCheckpointKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{31}
+}
+func (m *QueryCheckpointKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointKeysRequest.Merge(m, src)
+}
+func (m *QueryCheckpointKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointKeysRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointKeysRequest) GetConsAddr() string {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return ""
+}
+
+// QueryCheckpointKeysResponse holds the current default checkpoint key, the
+// pending key of a scheduled rotation and the key replaced last. Unset keys
+// are omitted.
+type QueryCheckpointKeysResponse struct {
+	Current  *CheckpointKeyRecord `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	Pending  *CheckpointKeyRecord `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Previous *CheckpointKeyRecord `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+}
+
+func (m *QueryCheckpointKeysResponse) Reset()         { *m = QueryCheckpointKeysResponse{} }
+func (m *QueryCheckpointKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointKeysResponse) ProtoMessage()    {}
+func (*QueryCheckpointKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{32}
+}
+func (m *QueryCheckpointKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointKeysResponse.Merge(m, src)
+}
+func (m *QueryCheckpointKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointKeysResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointKeysResponse) GetCurrent() *CheckpointKeyRecord {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *QueryCheckpointKeysResponse) GetPending() *CheckpointKeyRecord {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *QueryCheckpointKeysResponse) GetPrevious() *CheckpointKeyRecord {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
+type QueryOriginDomainRequest struct {
+	DomainId uint32 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+}
+
+func (m *QueryOriginDomainRequest) Reset()         { *m = QueryOriginDomainRequest{} }
+func (m *QueryOriginDomainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOriginDomainRequest) ProtoMessage()    {}
+func (*QueryOriginDomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{33}
+}
+func (m *QueryOriginDomainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOriginDomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOriginDomainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOriginDomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOriginDomainRequest.Merge(m, src)
+}
+func (m *QueryOriginDomainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOriginDomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOriginDomainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOriginDomainRequest proto.InternalMessageInfo
+
+func (m *QueryOriginDomainRequest) GetDomainId() uint32 {
+	if m != nil {
+		return m.DomainId
+	}
+	return 0
+}
+
+type QueryOriginDomainResponse struct {
+	Domain *OriginDomain `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (m *QueryOriginDomainResponse) Reset()         { *m = QueryOriginDomainResponse{} }
+func (m *QueryOriginDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOriginDomainResponse) ProtoMessage()    {}
+func (*QueryOriginDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{34}
+}
+func (m *QueryOriginDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOriginDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOriginDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOriginDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOriginDomainResponse.Merge(m, src)
+}
+func (m *QueryOriginDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOriginDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOriginDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOriginDomainResponse proto.InternalMessageInfo
+
+func (m *QueryOriginDomainResponse) GetDomain() *OriginDomain {
+	if m != nil {
+		return m.Domain
+	}
+	return nil
+}
+
+type QueryOriginDomainsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOriginDomainsRequest) Reset()         { *m = QueryOriginDomainsRequest{} }
+func (m *QueryOriginDomainsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOriginDomainsRequest) ProtoMessage()    {}
+func (*QueryOriginDomainsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{35}
+}
+func (m *QueryOriginDomainsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOriginDomainsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOriginDomainsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOriginDomainsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOriginDomainsRequest.Merge(m, src)
+}
+func (m *QueryOriginDomainsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOriginDomainsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOriginDomainsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOriginDomainsRequest proto.InternalMessageInfo
+
+func (m *QueryOriginDomainsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryOriginDomainsResponse struct {
+	Domains    []*OriginDomain     `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOriginDomainsResponse) Reset()         { *m = QueryOriginDomainsResponse{} }
+func (m *QueryOriginDomainsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOriginDomainsResponse) ProtoMessage()    {}
+func (*QueryOriginDomainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{36}
+}
+func (m *QueryOriginDomainsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOriginDomainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOriginDomainsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOriginDomainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOriginDomainsResponse.Merge(m, src)
+}
+func (m *QueryOriginDomainsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOriginDomainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOriginDomainsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOriginDomainsResponse proto.InternalMessageInfo
+
+func (m *QueryOriginDomainsResponse) GetDomains() []*OriginDomain {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
+
+func (m *QueryOriginDomainsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// validators are 20-byte checkpoint addresses (hex), as passed to
+// ValidatorAnnounce.getAnnouncedStorageLocations
+type QueryAnnouncedStorageLocationsRequest struct {
+	OriginDomain uint32   `protobuf:"varint,1,opt,name=origin_domain,json=originDomain,proto3" json:"origin_domain,omitempty"`
+	Validators   []string `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryAnnouncedStorageLocationsRequest) Reset()         { *m = QueryAnnouncedStorageLocationsRequest{} }
+func (m *QueryAnnouncedStorageLocationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnouncedStorageLocationsRequest) ProtoMessage()    {}
+func (*QueryAnnouncedStorageLocationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{37}
+}
+func (m *QueryAnnouncedStorageLocationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnouncedStorageLocationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnouncedStorageLocationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnouncedStorageLocationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnouncedStorageLocationsRequest.Merge(m, src)
+}
+func (m *QueryAnnouncedStorageLocationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnouncedStorageLocationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnouncedStorageLocationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnouncedStorageLocationsRequest proto.InternalMessageInfo
+
+func (m *QueryAnnouncedStorageLocationsRequest) GetOriginDomain() uint32 {
+	if m != nil {
+		return m.OriginDomain
+	}
+	return 0
+}
+
+func (m *QueryAnnouncedStorageLocationsRequest) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// storage_locations has one entry per requested validator, in request order,
+// mirroring the string[][] returned by getAnnouncedStorageLocations
+type QueryAnnouncedStorageLocationsResponse struct {
+	StorageLocations []*AnnouncedStorageLocations `protobuf:"bytes,1,rep,name=storage_locations,json=storageLocations,proto3" json:"storage_locations,omitempty"`
+}
+
+func (m *QueryAnnouncedStorageLocationsResponse) Reset() {
+	*m = QueryAnnouncedStorageLocationsResponse{}
+}
+func (m *QueryAnnouncedStorageLocationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnouncedStorageLocationsResponse) ProtoMessage()    {}
+func (*QueryAnnouncedStorageLocationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{38}
+}
+func (m *QueryAnnouncedStorageLocationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnouncedStorageLocationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnouncedStorageLocationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnouncedStorageLocationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnouncedStorageLocationsResponse.Merge(m, src)
+}
+func (m *QueryAnnouncedStorageLocationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnouncedStorageLocationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnouncedStorageLocationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnouncedStorageLocationsResponse proto.InternalMessageInfo
+
+func (m *QueryAnnouncedStorageLocationsResponse) GetStorageLocations() []*AnnouncedStorageLocations {
+	if m != nil {
+		return m.StorageLocations
+	}
+	return nil
+}
+
+type AnnouncedStorageLocations struct {
+	Locations []string `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+}
+
+func (m *AnnouncedStorageLocations) Reset()         { *m = AnnouncedStorageLocations{} }
+func (m *AnnouncedStorageLocations) String() string { return proto.CompactTextString(m) }
+func (*AnnouncedStorageLocations) ProtoMessage()    {}
+func (*AnnouncedStorageLocations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{39}
+}
+func (m *AnnouncedStorageLocations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnnouncedStorageLocations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AnnouncedStorageLocations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AnnouncedStorageLocations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnouncedStorageLocations.Merge(m, src)
+}
+func (m *AnnouncedStorageLocations) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnnouncedStorageLocations) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnouncedStorageLocations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnouncedStorageLocations proto.InternalMessageInfo
+
+func (m *AnnouncedStorageLocations) GetLocations() []string {
+	if m != nil {
+		return m.Locations
+	}
+	return nil
+}
+
+type QueryCheckpointSigningInfoRequest struct {
+	ConsAddr string `protobuf:"bytes,1,opt,name=cons_addr,json=consAddr,proto3" json:"cons_addr,omitempty"`
+}
+
+func (m *QueryCheckpointSigningInfoRequest) Reset()         { *m = QueryCheckpointSigningInfoRequest{} }
+func (m *QueryCheckpointSigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointSigningInfoRequest) ProtoMessage()    {}
+func (*QueryCheckpointSigningInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{40}
+}
+func (m *QueryCheckpointSigningInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointSigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointSigningInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointSigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointSigningInfoRequest.Merge(m, src)
+}
+func (m *QueryCheckpointSigningInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointSigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointSigningInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointSigningInfoRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointSigningInfoRequest) GetConsAddr() string {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return ""
+}
+
+type QueryCheckpointSigningInfoResponse struct {
+	Info *CheckpointSigningInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *QueryCheckpointSigningInfoResponse) Reset()         { *m = QueryCheckpointSigningInfoResponse{} }
+func (m *QueryCheckpointSigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointSigningInfoResponse) ProtoMessage()    {}
+func (*QueryCheckpointSigningInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_206b460e33a669c0, []int{41}
+}
+func (m *QueryCheckpointSigningInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointSigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointSigningInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointSigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointSigningInfoResponse.Merge(m, src)
+}
+func (m *QueryCheckpointSigningInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointSigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointSigningInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointSigningInfoResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointSigningInfoResponse) GetInfo() *CheckpointSigningInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("duty.v1.DutySetOrder", DutySetOrder_name, DutySetOrder_value)
+	proto.RegisterType((*QueryDutySetRequest)(nil), "duty.v1.QueryDutySetRequest")
+	proto.RegisterType((*DutyValidator)(nil), "duty.v1.DutyValidator")
+	proto.RegisterType((*QueryDutySetResponse)(nil), "duty.v1.QueryDutySetResponse")
+	proto.RegisterType((*QueryPendingDutySetRequest)(nil), "duty.v1.QueryPendingDutySetRequest")
+	proto.RegisterType((*QueryPendingDutySetResponse)(nil), "duty.v1.QueryPendingDutySetResponse")
+	proto.RegisterType((*QueryDutyMetadataRequest)(nil), "duty.v1.QueryDutyMetadataRequest")
+	proto.RegisterType((*QueryDutyMetadataResponse)(nil), "duty.v1.QueryDutyMetadataResponse")
+	proto.RegisterType((*DutySetSnapshot)(nil), "duty.v1.DutySetSnapshot")
+	proto.RegisterType((*QueryDutySetAtHeightRequest)(nil), "duty.v1.QueryDutySetAtHeightRequest")
+	proto.RegisterType((*QueryDutySetAtHeightResponse)(nil), "duty.v1.QueryDutySetAtHeightResponse")
+	proto.RegisterType((*QueryDutySetByEpochRequest)(nil), "duty.v1.QueryDutySetByEpochRequest")
+	proto.RegisterType((*QueryDutySetByEpochResponse)(nil), "duty.v1.QueryDutySetByEpochResponse")
+	proto.RegisterType((*QueryCheckpointRequest)(nil), "duty.v1.QueryCheckpointRequest")
+	proto.RegisterType((*QueryCheckpointResponse)(nil), "duty.v1.QueryCheckpointResponse")
+	proto.RegisterType((*QueryDutyValidatorByCheckpointKeyRequest)(nil), "duty.v1.QueryDutyValidatorByCheckpointKeyRequest")
+	proto.RegisterType((*QueryDutyValidatorByCheckpointKeyResponse)(nil), "duty.v1.QueryDutyValidatorByCheckpointKeyResponse")
+	proto.RegisterType((*QueryDutyMetadataByOperatorRequest)(nil), "duty.v1.QueryDutyMetadataByOperatorRequest")
+	proto.RegisterType((*QueryDutyMetadataByOperatorResponse)(nil), "duty.v1.QueryDutyMetadataByOperatorResponse")
+	proto.RegisterType((*QueryDutyValidatorRequest)(nil), "duty.v1.QueryDutyValidatorRequest")
+	proto.RegisterType((*QueryDutyValidatorResponse)(nil), "duty.v1.QueryDutyValidatorResponse")
+	proto.RegisterType((*DutySetHealth)(nil), "duty.v1.DutySetHealth")
+	proto.RegisterType((*QueryDutySetHealthRequest)(nil), "duty.v1.QueryDutySetHealthRequest")
+	proto.RegisterType((*QueryDutySetHealthResponse)(nil), "duty.v1.QueryDutySetHealthResponse")
+	proto.RegisterType((*QueryArchivedDutyMetadataRequest)(nil), "duty.v1.QueryArchivedDutyMetadataRequest")
+	proto.RegisterType((*QueryArchivedDutyMetadataResponse)(nil), "duty.v1.QueryArchivedDutyMetadataResponse")
+	proto.RegisterType((*QueryArchivedDutyMetadataByCheckpointKeyRequest)(nil), "duty.v1.QueryArchivedDutyMetadataByCheckpointKeyRequest")
+	proto.RegisterType((*QueryArchivedDutyMetadataByCheckpointKeyResponse)(nil), "duty.v1.QueryArchivedDutyMetadataByCheckpointKeyResponse")
+	proto.RegisterType((*QueryConsensusKeyMigrationRequest)(nil), "duty.v1.QueryConsensusKeyMigrationRequest")
+	proto.RegisterType((*QueryConsensusKeyMigrationResponse)(nil), "duty.v1.QueryConsensusKeyMigrationResponse")
+	proto.RegisterType((*QueryDutyNonceRequest)(nil), "duty.v1.QueryDutyNonceRequest")
+	proto.RegisterType((*QueryDutyNonceResponse)(nil), "duty.v1.QueryDutyNonceResponse")
+	proto.RegisterType((*QueryCheckpointKeysRequest)(nil), "duty.v1.QueryCheckpointKeysRequest")
+	proto.RegisterType((*QueryCheckpointKeysResponse)(nil), "duty.v1.QueryCheckpointKeysResponse")
+	proto.RegisterType((*QueryOriginDomainRequest)(nil), "duty.v1.QueryOriginDomainRequest")
+	proto.RegisterType((*QueryOriginDomainResponse)(nil), "duty.v1.QueryOriginDomainResponse")
+	proto.RegisterType((*QueryOriginDomainsRequest)(nil), "duty.v1.QueryOriginDomainsRequest")
+	proto.RegisterType((*QueryOriginDomainsResponse)(nil), "duty.v1.QueryOriginDomainsResponse")
+	proto.RegisterType((*QueryAnnouncedStorageLocationsRequest)(nil), "duty.v1.QueryAnnouncedStorageLocationsRequest")
+	proto.RegisterType((*QueryAnnouncedStorageLocationsResponse)(nil), "duty.v1.QueryAnnouncedStorageLocationsResponse")
+	proto.RegisterType((*AnnouncedStorageLocations)(nil), "duty.v1.AnnouncedStorageLocations")
+	proto.RegisterType((*QueryCheckpointSigningInfoRequest)(nil), "duty.v1.QueryCheckpointSigningInfoRequest")
+	proto.RegisterType((*QueryCheckpointSigningInfoResponse)(nil), "duty.v1.QueryCheckpointSigningInfoResponse")
+}
+
+func init() { proto.RegisterFile("duty/v1/queries.proto", fileDescriptor_206b460e33a669c0) }
+
+var fileDescriptor_206b460e33a669c0 = []byte{
+	// 2116 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x73, 0x1b, 0x4b,
+	0x15, 0x8e, 0xe4, 0x87, 0xa4, 0xe3, 0x67, 0xfa, 0x3a, 0x46, 0x1e, 0x3b, 0x8e, 0x33, 0x4e, 0xee,
+	0x75, 0x9c, 0x20, 0xdd, 0x18, 0x07, 0x6e, 0xaa, 0x28, 0x82, 0x9f, 0xe4, 0x92, 0x87, 0x9d, 0xb1,
+	0x73, 0x6f, 0x80, 0x2a, 0xa6, 0xc6, 0x9a, 0x8e, 0x34, 0x15, 0x69, 0x5a, 0x99, 0xee, 0x51, 0xa2,
+	0x82, 0x82, 0x15, 0xac, 0x58, 0xb0, 0x62, 0xc1, 0x8a, 0xdf, 0xc0, 0x82, 0x2a, 0x7e, 0x01, 0x2c,
+	0xef, 0x8a, 0x62, 0x49, 0x25, 0xfc, 0x04, 0x7e, 0x00, 0x35, 0x3d, 0x67, 0x9e, 0x1a, 0x8d, 0x15,
+	0xf3, 0xb8, 0x3b, 0xcd, 0xe9, 0xef, 0x9c, 0x3e, 0xe7, 0xeb, 0xd3, 0x7d, 0x4e, 0xb7, 0xe0, 0x8a,
+	0xe9, 0x8a, 0x7e, 0xbd, 0x77, 0xb7, 0xfe, 0xda, 0xa5, 0x8e, 0x45, 0x79, 0xad, 0xeb, 0x30, 0xc1,
+	0x48, 0xc9, 0x13, 0xd7, 0x7a, 0x77, 0x95, 0xf9, 0x60, 0x5c, 0xbc, 0xf5, 0x87, 0x94, 0x85, 0x40,
+	0xd2, 0x35, 0x1c, 0xa3, 0xc3, 0xd3, 0x52, 0x93, 0x75, 0x0c, 0xcb, 0x46, 0xe9, 0x66, 0x83, 0xf1,
+	0x0e, 0xe3, 0xf5, 0x33, 0x83, 0x53, 0x39, 0x83, 0x07, 0x39, 0xa3, 0xc2, 0xf0, 0xb4, 0x9b, 0x96,
+	0x6d, 0x08, 0x8b, 0x21, 0x56, 0xfd, 0x63, 0x11, 0x3e, 0x7a, 0xe6, 0x41, 0xf6, 0x5d, 0xd1, 0x3f,
+	0xa1, 0x42, 0xa3, 0xaf, 0x5d, 0xca, 0x05, 0x39, 0x04, 0x88, 0xb0, 0xd5, 0xc2, 0x5a, 0x61, 0x63,
+	0x6a, 0xeb, 0xe3, 0x9a, 0x6f, 0xb8, 0xe6, 0x19, 0xae, 0x49, 0xc3, 0x35, 0x34, 0x5c, 0x3b, 0x36,
+	0x9a, 0x14, 0x75, 0xb5, 0x98, 0x26, 0xb9, 0x03, 0x84, 0xd9, 0xed, 0xbe, 0xfe, 0xc6, 0x12, 0x2d,
+	0xbd, 0x43, 0x85, 0x61, 0x1a, 0xc2, 0xa8, 0x16, 0xd7, 0x0a, 0x1b, 0x65, 0x6d, 0xde, 0x1b, 0xf9,
+	0xd2, 0x12, 0xad, 0x27, 0x28, 0x27, 0xb7, 0x60, 0xbe, 0x63, 0x71, 0x6e, 0xd9, 0xcd, 0x08, 0x3b,
+	0x26, 0xb1, 0x73, 0x28, 0x0f, 0xa1, 0x1b, 0x1e, 0xd4, 0xd6, 0x7b, 0x4c, 0x78, 0xe8, 0x2e, 0x7b,
+	0x43, 0x9d, 0xea, 0xf8, 0x5a, 0x61, 0xa3, 0xa2, 0xcd, 0x76, 0x2c, 0xfb, 0x0b, 0x29, 0x3e, 0xf6,
+	0xa4, 0xe4, 0x36, 0x4c, 0x30, 0xc7, 0xa4, 0x4e, 0x75, 0x62, 0xad, 0xb0, 0x31, 0xbb, 0x75, 0xa5,
+	0x86, 0x2c, 0xd7, 0x30, 0xe4, 0x23, 0x6f, 0x50, 0xf3, 0x31, 0x64, 0x1d, 0x66, 0x98, 0x63, 0x35,
+	0x2d, 0x5b, 0xf7, 0x29, 0xad, 0x4e, 0xae, 0x15, 0x36, 0x66, 0xb4, 0x69, 0x5f, 0xb8, 0x2f, 0x65,
+	0xea, 0x9f, 0x8b, 0x30, 0xe3, 0x29, 0x7f, 0x61, 0xb4, 0x2d, 0xd3, 0x10, 0xcc, 0x21, 0x2a, 0xcc,
+	0xf4, 0x8c, 0xb6, 0xde, 0x60, 0x36, 0xd7, 0x0d, 0xd3, 0x74, 0x24, 0x63, 0x15, 0x6d, 0xaa, 0x67,
+	0xb4, 0xf7, 0x98, 0xcd, 0x77, 0x4c, 0xd3, 0x21, 0xd7, 0x61, 0x3a, 0xe1, 0x6d, 0x11, 0x21, 0x31,
+	0x57, 0xef, 0x00, 0x69, 0xb4, 0x68, 0xe3, 0x55, 0x97, 0x59, 0xb6, 0xd0, 0xbb, 0xee, 0x99, 0xfe,
+	0x8a, 0xf6, 0x25, 0x03, 0x15, 0x6d, 0x3e, 0x1a, 0x39, 0x76, 0xcf, 0x1e, 0xd1, 0x3e, 0xd9, 0x86,
+	0xc5, 0x18, 0x9a, 0x0b, 0xe6, 0x18, 0x4d, 0xaa, 0xbb, 0x8e, 0x85, 0x44, 0x2c, 0x44, 0xa3, 0x27,
+	0xfe, 0xe0, 0x73, 0xc7, 0x22, 0x8b, 0x30, 0x29, 0xd8, 0x2b, 0x6a, 0x73, 0xc9, 0x47, 0x45, 0xc3,
+	0x2f, 0x72, 0x15, 0xe0, 0x0d, 0xb5, 0x9a, 0x2d, 0xa1, 0x9f, 0x75, 0x39, 0x86, 0x5d, 0xf1, 0x25,
+	0xbb, 0x5d, 0x4e, 0x0e, 0x61, 0xd6, 0x67, 0xc4, 0x0b, 0xf2, 0xa5, 0xd5, 0xe4, 0xd5, 0xd2, 0xda,
+	0xd8, 0xc6, 0xd4, 0xd6, 0xb5, 0x88, 0x4e, 0x39, 0xbc, 0x17, 0xce, 0xb9, 0x27, 0x71, 0xda, 0x8c,
+	0xaf, 0xe6, 0x7f, 0x71, 0xf5, 0x9f, 0x45, 0x58, 0x48, 0x26, 0x1c, 0xef, 0x32, 0x9b, 0x53, 0xf2,
+	0x6d, 0x80, 0x5e, 0xc0, 0x27, 0xaf, 0x16, 0xa4, 0xf1, 0xc5, 0xc4, 0x5a, 0x85, 0x74, 0x6b, 0x31,
+	0xa4, 0xe7, 0xf7, 0x6b, 0x97, 0x39, 0x6e, 0x47, 0xb7, 0xdd, 0x8e, 0x24, 0x75, 0x46, 0xab, 0xf8,
+	0x92, 0xa7, 0x6e, 0x27, 0x36, 0x6c, 0x52, 0xbb, 0x3a, 0x16, 0x1f, 0xde, 0xa7, 0x36, 0x59, 0x80,
+	0x09, 0xda, 0x65, 0x8d, 0x96, 0xa4, 0x6c, 0x5c, 0xf3, 0x3f, 0x3c, 0x8e, 0x5a, 0x32, 0x72, 0xc9,
+	0xd1, 0x98, 0x86, 0x5f, 0xe4, 0x07, 0x89, 0x5d, 0x31, 0x29, 0x77, 0xc5, 0x27, 0xe7, 0xee, 0x0a,
+	0x3f, 0xc0, 0xc4, 0xb6, 0xd8, 0x86, 0x29, 0xf4, 0xaa, 0xc3, 0x4c, 0x5a, 0x2d, 0xc9, 0xcc, 0xfc,
+	0x28, 0x8c, 0xf6, 0x99, 0x1c, 0x7b, 0xc2, 0x4c, 0xaa, 0xc1, 0xeb, 0xf0, 0xb7, 0xb7, 0x3d, 0x50,
+	0x4b, 0xb4, 0x1c, 0xca, 0x5b, 0xac, 0x6d, 0x56, 0xcb, 0x72, 0x11, 0xe7, 0x7c, 0xf9, 0x69, 0x20,
+	0x56, 0x57, 0x40, 0x91, 0x2c, 0x1f, 0x53, 0xdb, 0xb4, 0xec, 0x66, 0x72, 0x77, 0xab, 0x7f, 0x28,
+	0xc2, 0x72, 0xe6, 0xf0, 0xd7, 0xba, 0x16, 0x9b, 0x70, 0xd9, 0xa6, 0x6f, 0x85, 0x2e, 0xd7, 0x40,
+	0xc7, 0x05, 0x18, 0x97, 0x0b, 0x30, 0xe7, 0x0d, 0x1c, 0x78, 0xf2, 0x87, 0xfe, 0x4a, 0xa4, 0x08,
+	0x9c, 0xb8, 0x38, 0x81, 0x93, 0xd9, 0x04, 0x7e, 0x07, 0xaa, 0x61, 0x9a, 0x06, 0x87, 0x4e, 0x70,
+	0x38, 0x2e, 0x43, 0x25, 0xbd, 0xd3, 0xcb, 0x0d, 0xdc, 0xe6, 0xea, 0x53, 0x58, 0xca, 0x50, 0x44,
+	0x62, 0xef, 0x42, 0x39, 0x3c, 0xd8, 0xfc, 0x43, 0x35, 0x79, 0x1c, 0x85, 0x0a, 0x21, 0x4c, 0xfd,
+	0x75, 0x11, 0xe6, 0x70, 0x7d, 0x4e, 0x6c, 0xa3, 0xcb, 0x5b, 0x4c, 0x44, 0x59, 0x5b, 0xc8, 0xce,
+	0xda, 0x62, 0x22, 0x6b, 0x93, 0xab, 0x39, 0x76, 0xc1, 0xd5, 0x1c, 0xcf, 0x5f, 0xcd, 0x89, 0xf4,
+	0x6a, 0x2e, 0x41, 0x99, 0x53, 0xa1, 0xb7, 0x0c, 0xde, 0x92, 0x1c, 0x4f, 0x6b, 0x25, 0x4e, 0xc5,
+	0x43, 0x83, 0xb7, 0x2e, 0x96, 0xfd, 0xea, 0x3d, 0xcc, 0x59, 0x24, 0x63, 0x47, 0xf8, 0xa9, 0x10,
+	0x2c, 0x4a, 0x14, 0x7d, 0x21, 0x1e, 0xbd, 0xfa, 0x4b, 0x58, 0xc9, 0x56, 0xc3, 0x25, 0xd9, 0x86,
+	0x32, 0x47, 0x5e, 0x71, 0x49, 0xaa, 0xe9, 0x0a, 0x11, 0xf0, 0xae, 0x85, 0xc8, 0xcc, 0x4c, 0x2a,
+	0x66, 0x67, 0xd2, 0x16, 0x6e, 0x45, 0x34, 0xb6, 0xdb, 0x97, 0x79, 0x1c, 0xb8, 0x9d, 0xb9, 0x94,
+	0xea, 0x2f, 0x60, 0x39, 0x53, 0xe7, 0xff, 0xe5, 0xf3, 0x09, 0x2c, 0xca, 0xf9, 0xa3, 0xd3, 0x3c,
+	0xf0, 0x77, 0xa0, 0x40, 0x16, 0x06, 0x0b, 0xa4, 0x17, 0x94, 0x65, 0x9b, 0xf4, 0x2d, 0x1e, 0x01,
+	0xfe, 0x87, 0x7a, 0x0c, 0xdf, 0x18, 0x30, 0x8a, 0x01, 0xdd, 0x83, 0xa9, 0xa8, 0x58, 0x05, 0x27,
+	0x4e, 0x94, 0x11, 0x31, 0x8d, 0x38, 0x4e, 0x7d, 0x06, 0x1b, 0x21, 0x4d, 0x61, 0x0e, 0xef, 0xc6,
+	0x26, 0x78, 0x44, 0xfb, 0x81, 0xe3, 0x37, 0x61, 0x36, 0x56, 0x2d, 0xbd, 0xba, 0xea, 0xef, 0xdc,
+	0x99, 0x46, 0x1c, 0xad, 0xfe, 0x0c, 0x6e, 0x8d, 0x60, 0x12, 0xdd, 0xce, 0x3b, 0x08, 0x12, 0x7b,
+	0xbd, 0x38, 0xda, 0x5e, 0x7f, 0x00, 0xea, 0xc0, 0xd9, 0xb1, 0xdb, 0x3f, 0xea, 0x52, 0x47, 0x6e,
+	0x4e, 0x8c, 0x64, 0x09, 0xca, 0x5e, 0xb3, 0x11, 0x9b, 0xb4, 0xd4, 0x33, 0xda, 0xf2, 0xf0, 0x71,
+	0x61, 0x3d, 0xd7, 0xc0, 0xff, 0xc8, 0xef, 0x7b, 0xb0, 0x34, 0x48, 0x5a, 0xe0, 0x6e, 0x15, 0x4a,
+	0xde, 0x3c, 0x94, 0xf3, 0xc0, 0x5b, 0xfc, 0x54, 0xff, 0x55, 0x00, 0x25, 0x4b, 0x0f, 0xbd, 0x1c,
+	0x1e, 0x67, 0x32, 0x80, 0x62, 0x2a, 0x80, 0x2a, 0x94, 0x3a, 0xcc, 0xb6, 0x5e, 0x51, 0x07, 0x5b,
+	0xa7, 0xe0, 0xd3, 0x3b, 0x23, 0xb8, 0x30, 0x84, 0xcb, 0xb1, 0x43, 0xc2, 0xaf, 0x81, 0xd6, 0x6c,
+	0x62, 0xb0, 0x35, 0x8b, 0xda, 0xa6, 0xc9, 0x44, 0xdb, 0x14, 0x67, 0xab, 0x34, 0x1a, 0x5b, 0x7f,
+	0x1a, 0xf7, 0xdb, 0xc7, 0x13, 0x2a, 0x1e, 0x52, 0xa3, 0x2d, 0x5a, 0xe4, 0x1a, 0x4c, 0x09, 0x26,
+	0x8c, 0x36, 0x4e, 0xef, 0x07, 0x0b, 0x52, 0xe4, 0xcf, 0xfe, 0x29, 0x2c, 0xc8, 0x83, 0x39, 0x6c,
+	0x8b, 0x13, 0x3d, 0x24, 0x91, 0x63, 0xc1, 0x4c, 0xbe, 0xc6, 0x36, 0x2c, 0xa6, 0x5b, 0x69, 0xd4,
+	0xf1, 0x39, 0x59, 0x48, 0x35, 0xd4, 0xa1, 0x96, 0x65, 0x67, 0xce, 0x84, 0x2d, 0x25, 0x8e, 0x0e,
+	0x68, 0xa5, 0x0f, 0x96, 0x04, 0x91, 0x0b, 0xa9, 0xe3, 0x25, 0xe8, 0xcb, 0x2f, 0xa3, 0x96, 0xd1,
+	0x68, 0x59, 0xb4, 0x67, 0x9c, 0xb5, 0xa9, 0x24, 0xb7, 0xac, 0xe1, 0x39, 0xb5, 0x13, 0xca, 0xc9,
+	0x27, 0x30, 0x17, 0x56, 0x26, 0xbd, 0xc1, 0x5c, 0x5b, 0x48, 0xb6, 0x67, 0xb4, 0xd9, 0x50, 0xbc,
+	0xe7, 0x49, 0x33, 0x98, 0xf2, 0xd1, 0x65, 0x89, 0x4e, 0x32, 0xe5, 0x6b, 0x24, 0xcb, 0x5c, 0x25,
+	0xbf, 0xcc, 0x41, 0xba, 0xcc, 0xa5, 0x6a, 0xd9, 0xd4, 0x68, 0x8d, 0x48, 0x16, 0x63, 0xbe, 0x9f,
+	0xd3, 0x72, 0x82, 0x34, 0x63, 0xd2, 0x53, 0x75, 0x39, 0xb6, 0xcd, 0xc2, 0xe4, 0x09, 0x7a, 0xba,
+	0xc7, 0xa0, 0x64, 0x0d, 0xe2, 0x5e, 0xaa, 0x79, 0xd5, 0xd1, 0x93, 0x60, 0xbd, 0x58, 0x4c, 0xd7,
+	0x0b, 0xc4, 0x23, 0x4a, 0x7d, 0x00, 0x6b, 0xd2, 0xda, 0x8e, 0xd3, 0x68, 0x59, 0x3d, 0x6a, 0x7e,
+	0x70, 0x1b, 0x64, 0xc0, 0xf5, 0x1c, 0x03, 0xe8, 0xd5, 0x77, 0xa1, 0x22, 0x58, 0xe7, 0x8c, 0x0b,
+	0x66, 0x53, 0x74, 0x6c, 0x35, 0x73, 0xf7, 0x9c, 0x06, 0x28, 0x2d, 0x52, 0x50, 0x5f, 0x40, 0x7d,
+	0xe8, 0x14, 0xff, 0x59, 0x11, 0x70, 0xe0, 0xd3, 0xd1, 0x2d, 0x63, 0x2c, 0xdf, 0x03, 0x08, 0x5d,
+	0x0b, 0x2a, 0xd8, 0x79, 0xc1, 0xc4, 0x34, 0xd4, 0xef, 0x23, 0x61, 0xde, 0x7d, 0x91, 0xda, 0xdc,
+	0xe5, 0x8f, 0x68, 0xff, 0x89, 0xd5, 0x74, 0xe4, 0x85, 0x61, 0x24, 0xca, 0xff, 0x56, 0x00, 0x35,
+	0xcf, 0x04, 0x3a, 0xfa, 0x00, 0xa6, 0x3a, 0x52, 0x48, 0x4d, 0x5d, 0xb0, 0x01, 0xda, 0xb3, 0x95,
+	0x21, 0x50, 0x39, 0x65, 0x64, 0x0f, 0x66, 0x42, 0x03, 0x2f, 0x1d, 0xd6, 0xa9, 0x16, 0x47, 0x32,
+	0x31, 0x1d, 0x28, 0x1d, 0x3a, 0xac, 0xe3, 0x35, 0xfb, 0x0d, 0xd7, 0x71, 0xa8, 0x2d, 0x62, 0xb7,
+	0x66, 0xff, 0x68, 0x9a, 0xc3, 0x81, 0xe0, 0xe6, 0xac, 0x6e, 0xc3, 0x95, 0x30, 0xb5, 0x9f, 0x32,
+	0xbb, 0x41, 0x47, 0xa2, 0xa3, 0x06, 0x8b, 0x69, 0x2d, 0x64, 0x60, 0x01, 0x26, 0x6c, 0x4f, 0x10,
+	0xf4, 0x5c, 0xf2, 0x43, 0xbd, 0x8f, 0x1b, 0x28, 0xb1, 0xbc, 0x7c, 0xa4, 0xa9, 0xfe, 0x52, 0x80,
+	0xe5, 0x4c, 0xdd, 0xf0, 0x3e, 0x55, 0xc2, 0x98, 0x90, 0xee, 0x95, 0x8c, 0xd6, 0x46, 0x26, 0x53,
+	0x83, 0x39, 0xa6, 0x16, 0x80, 0x3d, 0xbd, 0xae, 0x7f, 0x43, 0xab, 0x16, 0x47, 0xd1, 0x43, 0x30,
+	0xf9, 0x0c, 0xca, 0x5d, 0x87, 0xf6, 0x2c, 0xe6, 0xf2, 0xea, 0xd8, 0x08, 0x8a, 0x21, 0x3a, 0xbc,
+	0xf6, 0x1c, 0xc5, 0xda, 0xb9, 0x18, 0x05, 0xf8, 0x04, 0x60, 0x99, 0xd8, 0xf6, 0x95, 0x7d, 0xc1,
+	0xe7, 0xa6, 0xfa, 0x43, 0x58, 0xca, 0x50, 0xc4, 0xf8, 0xbf, 0x09, 0x93, 0xb1, 0x6e, 0x31, 0x5e,
+	0x22, 0x13, 0x70, 0x04, 0xa9, 0x8d, 0x0c, 0x5b, 0xfc, 0xbf, 0xfc, 0x32, 0xa5, 0xfe, 0x2e, 0x68,
+	0x3e, 0x52, 0xb3, 0xa0, 0xcb, 0x75, 0x28, 0xf9, 0xde, 0x04, 0x7b, 0x79, 0x88, 0xcf, 0x01, 0x2a,
+	0xf5, 0x36, 0x50, 0xbc, 0xf0, 0xdb, 0x80, 0xda, 0x86, 0x9b, 0xfe, 0xe1, 0x63, 0xdb, 0xcc, 0xb5,
+	0x1b, 0xd4, 0xc4, 0xc7, 0x9b, 0xc7, 0xac, 0x21, 0x01, 0xfc, 0x83, 0x5a, 0xf1, 0xd5, 0xc4, 0xe5,
+	0xaf, 0xb8, 0x36, 0xe6, 0x75, 0x16, 0x91, 0x44, 0xed, 0xc3, 0xc7, 0xe7, 0xcd, 0x86, 0x8c, 0x1c,
+	0xc1, 0xe5, 0xe0, 0x8d, 0xa9, 0x1d, 0x0c, 0x22, 0x37, 0x6a, 0xc8, 0xcd, 0x70, 0x33, 0xf3, 0x3c,
+	0x25, 0x51, 0xef, 0xc3, 0xd2, 0x50, 0x38, 0x59, 0x81, 0x4a, 0x72, 0x96, 0x8a, 0x16, 0x09, 0xa2,
+	0xc3, 0x32, 0x7a, 0xe1, 0xb2, 0x9a, 0xb6, 0x65, 0x37, 0x3f, 0xb7, 0x5f, 0xb2, 0x91, 0xb6, 0xec,
+	0x0b, 0x50, 0xf3, 0x2c, 0x60, 0xcc, 0x5b, 0x30, 0x6e, 0xd9, 0x2f, 0x33, 0x0e, 0xc9, 0x4c, 0x2d,
+	0x89, 0xdd, 0xb4, 0x60, 0x3a, 0xfe, 0xb2, 0x48, 0xae, 0xc2, 0xd2, 0xfe, 0xf3, 0xd3, 0x1f, 0xe9,
+	0x27, 0x07, 0xa7, 0xfa, 0x91, 0xb6, 0x7f, 0xa0, 0xe9, 0xc7, 0x47, 0x5f, 0x1e, 0x68, 0xfa, 0xfe,
+	0xc1, 0xc9, 0xde, 0xfc, 0x25, 0xb2, 0x02, 0xd5, 0xcc, 0xe1, 0x9d, 0x93, 0xbd, 0xf9, 0x42, 0xc6,
+	0xe8, 0xde, 0xd1, 0xd3, 0x13, 0x7d, 0x67, 0x7f, 0x5f, 0x9b, 0x2f, 0x6e, 0xfd, 0x66, 0x0e, 0x26,
+	0x64, 0x14, 0xe4, 0x10, 0x4a, 0x38, 0x29, 0x59, 0x89, 0x35, 0x1f, 0x03, 0x0f, 0xbb, 0xca, 0xd5,
+	0x21, 0xa3, 0x18, 0xf0, 0x4f, 0x60, 0x36, 0xf9, 0x26, 0x44, 0xd6, 0x93, 0x0a, 0x99, 0x0f, 0x4a,
+	0xca, 0x8d, 0x7c, 0x10, 0x1a, 0x7f, 0x0e, 0xd3, 0xf1, 0x3a, 0x48, 0xae, 0x0f, 0xfa, 0x92, 0xea,
+	0x31, 0x14, 0x35, 0x0f, 0x82, 0x66, 0x39, 0x2c, 0x66, 0xdf, 0x77, 0xc8, 0xed, 0xe1, 0xda, 0x03,
+	0xd7, 0x2a, 0xe5, 0xce, 0x68, 0x60, 0x9c, 0xf4, 0xc5, 0xc0, 0x13, 0xf0, 0xa0, 0x7a, 0xfa, 0x2a,
+	0xa4, 0xac, 0xe7, 0x62, 0xd0, 0xf2, 0xaf, 0x0a, 0xb0, 0x92, 0x77, 0xfb, 0x24, 0x77, 0x73, 0xac,
+	0x64, 0xf7, 0x3d, 0xca, 0xd6, 0x87, 0xa8, 0xa0, 0x1f, 0x3f, 0x85, 0xb9, 0xd4, 0x9b, 0x09, 0xb9,
+	0x91, 0x99, 0x3c, 0xa9, 0x97, 0x18, 0xe5, 0xe6, 0x39, 0xa8, 0x28, 0xd5, 0x92, 0xcf, 0x1b, 0x64,
+	0x3d, 0x53, 0x31, 0xf9, 0x60, 0xa2, 0xdc, 0xc8, 0x07, 0x85, 0x87, 0x15, 0x44, 0x51, 0x91, 0x6b,
+	0x49, 0x9d, 0x81, 0x57, 0x0d, 0x65, 0x6d, 0x38, 0x00, 0x0d, 0x76, 0xe1, 0x4a, 0xe6, 0xa6, 0x27,
+	0x9b, 0xc3, 0x54, 0x07, 0x4f, 0x24, 0xe5, 0xf6, 0x48, 0xd8, 0x64, 0x86, 0x45, 0xb7, 0x44, 0x35,
+	0x33, 0xf2, 0xc4, 0x2d, 0x40, 0x59, 0xcf, 0xc5, 0x44, 0xfb, 0x30, 0x5e, 0xc3, 0xd2, 0xfb, 0x30,
+	0xa3, 0xf6, 0x2b, 0x6a, 0x1e, 0x24, 0x72, 0x38, 0x2e, 0xe7, 0x24, 0x47, 0x89, 0x0f, 0x71, 0x38,
+	0xbb, 0x18, 0xff, 0x3c, 0xaf, 0x52, 0xd4, 0x92, 0x16, 0xce, 0x2b, 0x9b, 0x4a, 0x7d, 0x64, 0x7c,
+	0x94, 0xa8, 0xc9, 0xbe, 0x2e, 0x9d, 0xa8, 0x99, 0x1d, 0xa3, 0x72, 0x23, 0x1f, 0x84, 0xc6, 0x1f,
+	0x43, 0x25, 0x6c, 0x50, 0xc9, 0xea, 0xe0, 0xea, 0xc5, 0xfb, 0x5d, 0xe5, 0xda, 0xd0, 0xf1, 0x58,
+	0x96, 0x66, 0x35, 0xdf, 0x03, 0x59, 0x9a, 0x73, 0xc9, 0x50, 0x6e, 0x8f, 0x84, 0xc5, 0x19, 0x3b,
+	0xb0, 0x90, 0x75, 0x4b, 0x22, 0xb7, 0x52, 0x2c, 0x0f, 0xbf, 0x47, 0x2a, 0x9b, 0xa3, 0x40, 0x71,
+	0xba, 0xdf, 0x17, 0x60, 0x7d, 0x84, 0x5b, 0x19, 0xf9, 0xec, 0x7c, 0x9b, 0x43, 0x8e, 0xca, 0xfb,
+	0x17, 0xd0, 0xf4, 0x9d, 0xdb, 0x3d, 0xfc, 0xeb, 0xbb, 0xd5, 0xc2, 0x57, 0xef, 0x56, 0x0b, 0xff,
+	0x78, 0xb7, 0x5a, 0xf8, 0xed, 0xfb, 0xd5, 0x4b, 0x5f, 0xbd, 0x5f, 0xbd, 0xf4, 0xf7, 0xf7, 0xab,
+	0x97, 0x7e, 0x7c, 0xa7, 0x69, 0x89, 0x96, 0x7b, 0x56, 0x6b, 0xb0, 0x4e, 0xfd, 0xb4, 0x45, 0x77,
+	0x1c, 0x61, 0x35, 0xdc, 0xb6, 0x64, 0xb2, 0xee, 0x59, 0xae, 0xbf, 0xad, 0xcb, 0xbf, 0x72, 0x45,
+	0xbf, 0x4b, 0xf9, 0xd9, 0xa4, 0xfc, 0x6f, 0xf6, 0x5b, 0xff, 0x1e, 0x00, 0x38, 0xf5, 0x2e, 0x5c,
+	0x27, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	DutySet(ctx context.Context, in *QueryDutySetRequest, opts ...grpc.CallOption) (*QueryDutySetResponse, error)
+	PendingDutySet(ctx context.Context, in *QueryPendingDutySetRequest, opts ...grpc.CallOption) (*QueryPendingDutySetResponse, error)
+	DutyMetadata(ctx context.Context, in *QueryDutyMetadataRequest, opts ...grpc.CallOption) (*QueryDutyMetadataResponse, error)
+	DutyMetadataByOperator(ctx context.Context, in *QueryDutyMetadataByOperatorRequest, opts ...grpc.CallOption) (*QueryDutyMetadataByOperatorResponse, error)
+	DutyValidator(ctx context.Context, in *QueryDutyValidatorRequest, opts ...grpc.CallOption) (*QueryDutyValidatorResponse, error)
+	DutyValidatorByCheckpointKey(ctx context.Context, in *QueryDutyValidatorByCheckpointKeyRequest, opts ...grpc.CallOption) (*QueryDutyValidatorByCheckpointKeyResponse, error)
+	DutySetAtHeight(ctx context.Context, in *QueryDutySetAtHeightRequest, opts ...grpc.CallOption) (*QueryDutySetAtHeightResponse, error)
+	DutySetByEpoch(ctx context.Context, in *QueryDutySetByEpochRequest, opts ...grpc.CallOption) (*QueryDutySetByEpochResponse, error)
+	Checkpoint(ctx context.Context, in *QueryCheckpointRequest, opts ...grpc.CallOption) (*QueryCheckpointResponse, error)
+	CheckpointSigningInfo(ctx context.Context, in *QueryCheckpointSigningInfoRequest, opts ...grpc.CallOption) (*QueryCheckpointSigningInfoResponse, error)
+	DutySetHealth(ctx context.Context, in *QueryDutySetHealthRequest, opts ...grpc.CallOption) (*QueryDutySetHealthResponse, error)
+	OriginDomain(ctx context.Context, in *QueryOriginDomainRequest, opts ...grpc.CallOption) (*QueryOriginDomainResponse, error)
+	OriginDomains(ctx context.Context, in *QueryOriginDomainsRequest, opts ...grpc.CallOption) (*QueryOriginDomainsResponse, error)
+	AnnouncedStorageLocations(ctx context.Context, in *QueryAnnouncedStorageLocationsRequest, opts ...grpc.CallOption) (*QueryAnnouncedStorageLocationsResponse, error)
+	CheckpointKeys(ctx context.Context, in *QueryCheckpointKeysRequest, opts ...grpc.CallOption) (*QueryCheckpointKeysResponse, error)
+	DutyNonce(ctx context.Context, in *QueryDutyNonceRequest, opts ...grpc.CallOption) (*QueryDutyNonceResponse, error)
+	ConsensusKeyMigration(ctx context.Context, in *QueryConsensusKeyMigrationRequest, opts ...grpc.CallOption) (*QueryConsensusKeyMigrationResponse, error)
+	ArchivedDutyMetadata(ctx context.Context, in *QueryArchivedDutyMetadataRequest, opts ...grpc.CallOption) (*QueryArchivedDutyMetadataResponse, error)
+	ArchivedDutyMetadataByCheckpointKey(ctx context.Context, in *QueryArchivedDutyMetadataByCheckpointKeyRequest, opts ...grpc.CallOption) (*QueryArchivedDutyMetadataByCheckpointKeyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DutySet(ctx context.Context, in *QueryDutySetRequest, opts ...grpc.CallOption) (*QueryDutySetResponse, error) {
+	out := new(QueryDutySetResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/DutySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingDutySet(ctx context.Context, in *QueryPendingDutySetRequest, opts ...grpc.CallOption) (*QueryPendingDutySetResponse, error) {
+	out := new(QueryPendingDutySetResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/PendingDutySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutyMetadata(ctx context.Context, in *QueryDutyMetadataRequest, opts ...grpc.CallOption) (*QueryDutyMetadataResponse, error) {
+	out := new(QueryDutyMetadataResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/DutyMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutyMetadataByOperator(ctx context.Context, in *QueryDutyMetadataByOperatorRequest, opts ...grpc.CallOption) (*QueryDutyMetadataByOperatorResponse, error) {
+	out := new(QueryDutyMetadataByOperatorResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/DutyMetadataByOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutyValidator(ctx context.Context, in *QueryDutyValidatorRequest, opts ...grpc.CallOption) (*QueryDutyValidatorResponse, error) {
+	out := new(QueryDutyValidatorResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/DutyValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutyValidatorByCheckpointKey(ctx context.Context, in *QueryDutyValidatorByCheckpointKeyRequest, opts ...grpc.CallOption) (*QueryDutyValidatorByCheckpointKeyResponse, error) {
+	out := new(QueryDutyValidatorByCheckpointKeyResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/DutyValidatorByCheckpointKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutySetAtHeight(ctx context.Context, in *QueryDutySetAtHeightRequest, opts ...grpc.CallOption) (*QueryDutySetAtHeightResponse, error) {
+	out := new(QueryDutySetAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/DutySetAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutySetByEpoch(ctx context.Context, in *QueryDutySetByEpochRequest, opts ...grpc.CallOption) (*QueryDutySetByEpochResponse, error) {
+	out := new(QueryDutySetByEpochResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/DutySetByEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Checkpoint(ctx context.Context, in *QueryCheckpointRequest, opts ...grpc.CallOption) (*QueryCheckpointResponse, error) {
+	out := new(QueryCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointSigningInfo(ctx context.Context, in *QueryCheckpointSigningInfoRequest, opts ...grpc.CallOption) (*QueryCheckpointSigningInfoResponse, error) {
+	out := new(QueryCheckpointSigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/CheckpointSigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutySetHealth(ctx context.Context, in *QueryDutySetHealthRequest, opts ...grpc.CallOption) (*QueryDutySetHealthResponse, error) {
+	out := new(QueryDutySetHealthResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/DutySetHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OriginDomain(ctx context.Context, in *QueryOriginDomainRequest, opts ...grpc.CallOption) (*QueryOriginDomainResponse, error) {
+	out := new(QueryOriginDomainResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/OriginDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OriginDomains(ctx context.Context, in *QueryOriginDomainsRequest, opts ...grpc.CallOption) (*QueryOriginDomainsResponse, error) {
+	out := new(QueryOriginDomainsResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/OriginDomains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnouncedStorageLocations(ctx context.Context, in *QueryAnnouncedStorageLocationsRequest, opts ...grpc.CallOption) (*QueryAnnouncedStorageLocationsResponse, error) {
+	out := new(QueryAnnouncedStorageLocationsResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/AnnouncedStorageLocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointKeys(ctx context.Context, in *QueryCheckpointKeysRequest, opts ...grpc.CallOption) (*QueryCheckpointKeysResponse, error) {
+	out := new(QueryCheckpointKeysResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/CheckpointKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutyNonce(ctx context.Context, in *QueryDutyNonceRequest, opts ...grpc.CallOption) (*QueryDutyNonceResponse, error) {
+	out := new(QueryDutyNonceResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/DutyNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusKeyMigration(ctx context.Context, in *QueryConsensusKeyMigrationRequest, opts ...grpc.CallOption) (*QueryConsensusKeyMigrationResponse, error) {
+	out := new(QueryConsensusKeyMigrationResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/ConsensusKeyMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ArchivedDutyMetadata(ctx context.Context, in *QueryArchivedDutyMetadataRequest, opts ...grpc.CallOption) (*QueryArchivedDutyMetadataResponse, error) {
+	out := new(QueryArchivedDutyMetadataResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/ArchivedDutyMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ArchivedDutyMetadataByCheckpointKey(ctx context.Context, in *QueryArchivedDutyMetadataByCheckpointKeyRequest, opts ...grpc.CallOption) (*QueryArchivedDutyMetadataByCheckpointKeyResponse, error) {
+	out := new(QueryArchivedDutyMetadataByCheckpointKeyResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Query/ArchivedDutyMetadataByCheckpointKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	DutySet(context.Context, *QueryDutySetRequest) (*QueryDutySetResponse, error)
+	PendingDutySet(context.Context, *QueryPendingDutySetRequest) (*QueryPendingDutySetResponse, error)
+	DutyMetadata(context.Context, *QueryDutyMetadataRequest) (*QueryDutyMetadataResponse, error)
+	DutyMetadataByOperator(context.Context, *QueryDutyMetadataByOperatorRequest) (*QueryDutyMetadataByOperatorResponse, error)
+	DutyValidator(context.Context, *QueryDutyValidatorRequest) (*QueryDutyValidatorResponse, error)
+	DutyValidatorByCheckpointKey(context.Context, *QueryDutyValidatorByCheckpointKeyRequest) (*QueryDutyValidatorByCheckpointKeyResponse, error)
+	DutySetAtHeight(context.Context, *QueryDutySetAtHeightRequest) (*QueryDutySetAtHeightResponse, error)
+	DutySetByEpoch(context.Context, *QueryDutySetByEpochRequest) (*QueryDutySetByEpochResponse, error)
+	Checkpoint(context.Context, *QueryCheckpointRequest) (*QueryCheckpointResponse, error)
+	CheckpointSigningInfo(context.Context, *QueryCheckpointSigningInfoRequest) (*QueryCheckpointSigningInfoResponse, error)
+	DutySetHealth(context.Context, *QueryDutySetHealthRequest) (*QueryDutySetHealthResponse, error)
+	OriginDomain(context.Context, *QueryOriginDomainRequest) (*QueryOriginDomainResponse, error)
+	OriginDomains(context.Context, *QueryOriginDomainsRequest) (*QueryOriginDomainsResponse, error)
+	AnnouncedStorageLocations(context.Context, *QueryAnnouncedStorageLocationsRequest) (*QueryAnnouncedStorageLocationsResponse, error)
+	CheckpointKeys(context.Context, *QueryCheckpointKeysRequest) (*QueryCheckpointKeysResponse, error)
+	DutyNonce(context.Context, *QueryDutyNonceRequest) (*QueryDutyNonceResponse, error)
+	ConsensusKeyMigration(context.Context, *QueryConsensusKeyMigrationRequest) (*QueryConsensusKeyMigrationResponse, error)
+	ArchivedDutyMetadata(context.Context, *QueryArchivedDutyMetadataRequest) (*QueryArchivedDutyMetadataResponse, error)
+	ArchivedDutyMetadataByCheckpointKey(context.Context, *QueryArchivedDutyMetadataByCheckpointKeyRequest) (*QueryArchivedDutyMetadataByCheckpointKeyResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DutySet(ctx context.Context, req *QueryDutySetRequest) (*QueryDutySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutySet not implemented")
+}
+func (*UnimplementedQueryServer) PendingDutySet(ctx context.Context, req *QueryPendingDutySetRequest) (*QueryPendingDutySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingDutySet not implemented")
+}
+func (*UnimplementedQueryServer) DutyMetadata(ctx context.Context, req *QueryDutyMetadataRequest) (*QueryDutyMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutyMetadata not implemented")
+}
+func (*UnimplementedQueryServer) DutyMetadataByOperator(ctx context.Context, req *QueryDutyMetadataByOperatorRequest) (*QueryDutyMetadataByOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutyMetadataByOperator not implemented")
+}
+func (*UnimplementedQueryServer) DutyValidator(ctx context.Context, req *QueryDutyValidatorRequest) (*QueryDutyValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutyValidator not implemented")
+}
+func (*UnimplementedQueryServer) DutyValidatorByCheckpointKey(ctx context.Context, req *QueryDutyValidatorByCheckpointKeyRequest) (*QueryDutyValidatorByCheckpointKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutyValidatorByCheckpointKey not implemented")
+}
+func (*UnimplementedQueryServer) DutySetAtHeight(ctx context.Context, req *QueryDutySetAtHeightRequest) (*QueryDutySetAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutySetAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) DutySetByEpoch(ctx context.Context, req *QueryDutySetByEpochRequest) (*QueryDutySetByEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutySetByEpoch not implemented")
+}
+func (*UnimplementedQueryServer) Checkpoint(ctx context.Context, req *QueryCheckpointRequest) (*QueryCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointSigningInfo(ctx context.Context, req *QueryCheckpointSigningInfoRequest) (*QueryCheckpointSigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointSigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) DutySetHealth(ctx context.Context, req *QueryDutySetHealthRequest) (*QueryDutySetHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutySetHealth not implemented")
+}
+func (*UnimplementedQueryServer) OriginDomain(ctx context.Context, req *QueryOriginDomainRequest) (*QueryOriginDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OriginDomain not implemented")
+}
+func (*UnimplementedQueryServer) OriginDomains(ctx context.Context, req *QueryOriginDomainsRequest) (*QueryOriginDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OriginDomains not implemented")
+}
+func (*UnimplementedQueryServer) AnnouncedStorageLocations(ctx context.Context, req *QueryAnnouncedStorageLocationsRequest) (*QueryAnnouncedStorageLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnouncedStorageLocations not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointKeys(ctx context.Context, req *QueryCheckpointKeysRequest) (*QueryCheckpointKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointKeys not implemented")
+}
+func (*UnimplementedQueryServer) DutyNonce(ctx context.Context, req *QueryDutyNonceRequest) (*QueryDutyNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutyNonce not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusKeyMigration(ctx context.Context, req *QueryConsensusKeyMigrationRequest) (*QueryConsensusKeyMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusKeyMigration not implemented")
+}
+func (*UnimplementedQueryServer) ArchivedDutyMetadata(ctx context.Context, req *QueryArchivedDutyMetadataRequest) (*QueryArchivedDutyMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedDutyMetadata not implemented")
+}
+func (*UnimplementedQueryServer) ArchivedDutyMetadataByCheckpointKey(ctx context.Context, req *QueryArchivedDutyMetadataByCheckpointKeyRequest) (*QueryArchivedDutyMetadataByCheckpointKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedDutyMetadataByCheckpointKey not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DutySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutySetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/DutySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutySet(ctx, req.(*QueryDutySetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingDutySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingDutySetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingDutySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/PendingDutySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingDutySet(ctx, req.(*QueryPendingDutySetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutyMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutyMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutyMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/DutyMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutyMetadata(ctx, req.(*QueryDutyMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutyMetadataByOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutyMetadataByOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutyMetadataByOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/DutyMetadataByOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutyMetadataByOperator(ctx, req.(*QueryDutyMetadataByOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutyValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutyValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutyValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/DutyValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutyValidator(ctx, req.(*QueryDutyValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutyValidatorByCheckpointKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutyValidatorByCheckpointKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutyValidatorByCheckpointKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/DutyValidatorByCheckpointKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutyValidatorByCheckpointKey(ctx, req.(*QueryDutyValidatorByCheckpointKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutySetAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutySetAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutySetAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/DutySetAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutySetAtHeight(ctx, req.(*QueryDutySetAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutySetByEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutySetByEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutySetByEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/DutySetByEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutySetByEpoch(ctx, req.(*QueryDutySetByEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Checkpoint(ctx, req.(*QueryCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointSigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointSigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointSigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/CheckpointSigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointSigningInfo(ctx, req.(*QueryCheckpointSigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutySetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutySetHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutySetHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/DutySetHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutySetHealth(ctx, req.(*QueryDutySetHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OriginDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOriginDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OriginDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/OriginDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OriginDomain(ctx, req.(*QueryOriginDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OriginDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOriginDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OriginDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/OriginDomains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OriginDomains(ctx, req.(*QueryOriginDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnouncedStorageLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnouncedStorageLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnouncedStorageLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/AnnouncedStorageLocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnouncedStorageLocations(ctx, req.(*QueryAnnouncedStorageLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/CheckpointKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointKeys(ctx, req.(*QueryCheckpointKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutyNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutyNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutyNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/DutyNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutyNonce(ctx, req.(*QueryDutyNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusKeyMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusKeyMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusKeyMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/ConsensusKeyMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusKeyMigration(ctx, req.(*QueryConsensusKeyMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArchivedDutyMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedDutyMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedDutyMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/ArchivedDutyMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedDutyMetadata(ctx, req.(*QueryArchivedDutyMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArchivedDutyMetadataByCheckpointKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedDutyMetadataByCheckpointKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedDutyMetadataByCheckpointKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Query/ArchivedDutyMetadataByCheckpointKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedDutyMetadataByCheckpointKey(ctx, req.(*QueryArchivedDutyMetadataByCheckpointKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "duty.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DutySet",
+			Handler:    _Query_DutySet_Handler,
+		},
+		{
+			MethodName: "PendingDutySet",
+			Handler:    _Query_PendingDutySet_Handler,
+		},
+		{
+			MethodName: "DutyMetadata",
+			Handler:    _Query_DutyMetadata_Handler,
+		},
+		{
+			MethodName: "DutyMetadataByOperator",
+			Handler:    _Query_DutyMetadataByOperator_Handler,
+		},
+		{
+			MethodName: "DutyValidator",
+			Handler:    _Query_DutyValidator_Handler,
+		},
+		{
+			MethodName: "DutyValidatorByCheckpointKey",
+			Handler:    _Query_DutyValidatorByCheckpointKey_Handler,
+		},
+		{
+			MethodName: "DutySetAtHeight",
+			Handler:    _Query_DutySetAtHeight_Handler,
+		},
+		{
+			MethodName: "DutySetByEpoch",
+			Handler:    _Query_DutySetByEpoch_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Query_Checkpoint_Handler,
+		},
+		{
+			MethodName: "CheckpointSigningInfo",
+			Handler:    _Query_CheckpointSigningInfo_Handler,
+		},
+		{
+			MethodName: "DutySetHealth",
+			Handler:    _Query_DutySetHealth_Handler,
+		},
+		{
+			MethodName: "OriginDomain",
+			Handler:    _Query_OriginDomain_Handler,
+		},
+		{
+			MethodName: "OriginDomains",
+			Handler:    _Query_OriginDomains_Handler,
+		},
+		{
+			MethodName: "AnnouncedStorageLocations",
+			Handler:    _Query_AnnouncedStorageLocations_Handler,
+		},
+		{
+			MethodName: "CheckpointKeys",
+			Handler:    _Query_CheckpointKeys_Handler,
+		},
+		{
+			MethodName: "DutyNonce",
+			Handler:    _Query_DutyNonce_Handler,
+		},
+		{
+			MethodName: "ConsensusKeyMigration",
+			Handler:    _Query_ConsensusKeyMigration_Handler,
+		},
+		{
+			MethodName: "ArchivedDutyMetadata",
+			Handler:    _Query_ArchivedDutyMetadata_Handler,
+		},
+		{
+			MethodName: "ArchivedDutyMetadataByCheckpointKey",
+			Handler:    _Query_ArchivedDutyMetadataByCheckpointKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "duty/v1/queries.proto",
+}
+
+func (m *QueryDutySetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutySetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutySetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OriginDomain != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.OriginDomain))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Order != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MinVotingPower) > 0 {
+		i -= len(m.MinVotingPower)
+		copy(dAtA[i:], m.MinVotingPower)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.MinVotingPower)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MissingMetadata {
+		i--
+		if m.MissingMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OnlyWithMetadata {
+		i--
+		if m.OnlyWithMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutyValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutyValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutyValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DomainConfigs) > 0 {
+		for iNdEx := len(m.DomainConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueries(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.WeightBps != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.WeightBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Tokens) > 0 {
+		i -= len(m.Tokens)
+		copy(dAtA[i:], m.Tokens)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Tokens)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CheckpointStorageUri) > 0 {
+		i -= len(m.CheckpointStorageUri)
+		copy(dAtA[i:], m.CheckpointStorageUri)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CheckpointStorageUri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CheckpointPubKey) > 0 {
+		i -= len(m.CheckpointPubKey)
+		copy(dAtA[i:], m.CheckpointPubKey)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CheckpointPubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValConsAddr) > 0 {
+		i -= len(m.ValConsAddr)
+		copy(dAtA[i:], m.ValConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ValConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuorumThreshold) > 0 {
+		i -= len(m.QuorumThreshold)
+		copy(dAtA[i:], m.QuorumThreshold)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QuorumThreshold)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.QuorumMode != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QuorumDen != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumDen))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QuorumNum != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueries(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDutySetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDutySetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDutySetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDutySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDutySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDutySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuorumThreshold) > 0 {
+		i -= len(m.QuorumThreshold)
+		copy(dAtA[i:], m.QuorumThreshold)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QuorumThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.QuorumMode != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextEpochHeight != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.NextEpochHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QuorumDen != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumDen))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QuorumNum != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueries(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutyMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutyMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutyMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutyMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutyMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutyMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutySetSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutySetSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutySetSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumMode != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SetHash) > 0 {
+		i -= len(m.SetHash)
+		copy(dAtA[i:], m.SetHash)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.SetHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.QuorumDen != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumDen))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.QuorumNum != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumNum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueries(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutySetAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutySetAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutySetAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutySetAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutySetAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutySetAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuorumThreshold) > 0 {
+		i -= len(m.QuorumThreshold)
+		copy(dAtA[i:], m.QuorumThreshold)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QuorumThreshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutySetByEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutySetByEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutySetByEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutySetByEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutySetByEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutySetByEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuorumThreshold) > 0 {
+		i -= len(m.QuorumThreshold)
+		copy(dAtA[i:], m.QuorumThreshold)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QuorumThreshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OriginDomain != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.OriginDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueries(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CheckpointKey) > 0 {
+		i -= len(m.CheckpointKey)
+		copy(dAtA[i:], m.CheckpointKey)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CheckpointKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutyMetadataByOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutyMetadataByOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutyMetadataByOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutyMetadataByOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutyMetadataByOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutyMetadataByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutyValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutyValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutyValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutyValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutyValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutyValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Tokens) > 0 {
+		i -= len(m.Tokens)
+		copy(dAtA[i:], m.Tokens)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Tokens)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutySetHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutySetHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutySetHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumThresholdCount != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumThresholdCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.QuorumMode != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumMode))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.QuorumDen != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumDen))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.QuorumNum != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.QuorumNum))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ValidMetadataCount != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.ValidMetadataCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.QuorumAchievable {
+		i--
+		if m.QuorumAchievable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.QuorumThresholdPower) > 0 {
+		i -= len(m.QuorumThresholdPower)
+		copy(dAtA[i:], m.QuorumThresholdPower)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QuorumThresholdPower)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InvalidMetadataPower) > 0 {
+		i -= len(m.InvalidMetadataPower)
+		copy(dAtA[i:], m.InvalidMetadataPower)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.InvalidMetadataPower)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MissingMetadataPower) > 0 {
+		i -= len(m.MissingMetadataPower)
+		copy(dAtA[i:], m.MissingMetadataPower)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.MissingMetadataPower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidMetadataPower) > 0 {
+		i -= len(m.ValidMetadataPower)
+		copy(dAtA[i:], m.ValidMetadataPower)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ValidMetadataPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TotalPower) > 0 {
+		i -= len(m.TotalPower)
+		copy(dAtA[i:], m.TotalPower)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.TotalPower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutySetHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutySetHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutySetHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutySetHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutySetHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutySetHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArchivedDutyMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArchivedDutyMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArchivedDutyMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArchivedDutyMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArchivedDutyMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArchivedDutyMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstone != nil {
+		{
+			size, err := m.Tombstone.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CheckpointKey) > 0 {
+		i -= len(m.CheckpointKey)
+		copy(dAtA[i:], m.CheckpointKey)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CheckpointKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tombstones) > 0 {
+		for iNdEx := len(m.Tombstones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tombstones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueries(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusKeyMigrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusKeyMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusKeyMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusKeyMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusKeyMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusKeyMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentConsAddr) > 0 {
+		i -= len(m.CurrentConsAddr)
+		copy(dAtA[i:], m.CurrentConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CurrentConsAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MigratedFrom != nil {
+		{
+			size, err := m.MigratedFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MigratedTo != nil {
+		{
+			size, err := m.MigratedTo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutyNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutyNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutyNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutyNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutyNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutyNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Previous != nil {
+		{
+			size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pending != nil {
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Current != nil {
+		{
+			size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOriginDomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOriginDomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOriginDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DomainId != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.DomainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOriginDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOriginDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOriginDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Domain != nil {
+		{
+			size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOriginDomainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOriginDomainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOriginDomainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOriginDomainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOriginDomainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOriginDomainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domains) > 0 {
+		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueries(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnouncedStorageLocationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnouncedStorageLocationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnouncedStorageLocationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQueries(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.OriginDomain != 0 {
+		i = encodeVarintQueries(dAtA, i, uint64(m.OriginDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnouncedStorageLocationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnouncedStorageLocationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnouncedStorageLocationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageLocations) > 0 {
+		for iNdEx := len(m.StorageLocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageLocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueries(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnnouncedStorageLocations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnnouncedStorageLocations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnouncedStorageLocations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locations) > 0 {
+		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Locations[iNdEx])
+			copy(dAtA[i:], m.Locations[iNdEx])
+			i = encodeVarintQueries(dAtA, i, uint64(len(m.Locations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointSigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointSigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointSigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointSigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointSigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointSigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueries(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueries(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueries(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDutySetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.OnlyWithMetadata {
+		n += 2
+	}
+	if m.MissingMetadata {
+		n += 2
+	}
+	l = len(m.MinVotingPower)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovQueries(uint64(m.Order))
+	}
+	if m.OriginDomain != 0 {
+		n += 1 + sovQueries(uint64(m.OriginDomain))
+	}
+	return n
+}
+
+func (m *DutyValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.CheckpointPubKey)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.CheckpointStorageUri)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Tokens)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.WeightBps != 0 {
+		n += 1 + sovQueries(uint64(m.WeightBps))
+	}
+	if len(m.DomainConfigs) > 0 {
+		for _, e := range m.DomainConfigs {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDutySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if m.QuorumNum != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumNum))
+	}
+	if m.QuorumDen != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumDen))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQueries(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQueries(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.QuorumMode != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumMode))
+	}
+	l = len(m.QuorumThreshold)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingDutySetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingDutySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if m.QuorumNum != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumNum))
+	}
+	if m.QuorumDen != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumDen))
+	}
+	if m.NextEpochHeight != 0 {
+		n += 1 + sovQueries(uint64(m.NextEpochHeight))
+	}
+	if m.QuorumMode != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumMode))
+	}
+	l = len(m.QuorumThreshold)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutyMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutyMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *DutySetSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQueries(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQueries(uint64(m.Height))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if m.QuorumNum != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumNum))
+	}
+	if m.QuorumDen != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumDen))
+	}
+	l = len(m.SetHash)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.QuorumMode != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumMode))
+	}
+	return n
+}
+
+func (m *QueryDutySetAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQueries(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDutySetAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.QuorumThreshold)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutySetByEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQueries(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryDutySetByEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.QuorumThreshold)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OriginDomain != 0 {
+		n += 1 + sovQueries(uint64(m.OriginDomain))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQueries(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CheckpointKey)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutyValidatorByCheckpointKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutyMetadataByOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutyMetadataByOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutyValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutyValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Tokens)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *DutySetHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TotalPower)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.ValidMetadataPower)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.MissingMetadataPower)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.InvalidMetadataPower)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.QuorumThresholdPower)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.QuorumAchievable {
+		n += 2
+	}
+	if m.ValidatorCount != 0 {
+		n += 1 + sovQueries(uint64(m.ValidatorCount))
+	}
+	if m.ValidMetadataCount != 0 {
+		n += 1 + sovQueries(uint64(m.ValidMetadataCount))
+	}
+	if m.QuorumNum != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumNum))
+	}
+	if m.QuorumDen != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumDen))
+	}
+	if m.QuorumMode != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumMode))
+	}
+	if m.QuorumThresholdCount != 0 {
+		n += 1 + sovQueries(uint64(m.QuorumThresholdCount))
+	}
+	return n
+}
+
+func (m *QueryDutySetHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDutySetHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArchivedDutyMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArchivedDutyMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tombstone != nil {
+		l = m.Tombstone.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CheckpointKey)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tombstones) > 0 {
+		for _, e := range m.Tombstones {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsensusKeyMigrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsensusKeyMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigratedTo != nil {
+		l = m.MigratedTo.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.MigratedFrom != nil {
+		l = m.MigratedFrom.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.CurrentConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutyNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutyNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQueries(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryCheckpointKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Current != nil {
+		l = m.Current.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Previous != nil {
+		l = m.Previous.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOriginDomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DomainId != 0 {
+		n += 1 + sovQueries(uint64(m.DomainId))
+	}
+	return n
+}
+
+func (m *QueryOriginDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Domain != nil {
+		l = m.Domain.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOriginDomainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOriginDomainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for _, e := range m.Domains {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAnnouncedStorageLocationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OriginDomain != 0 {
+		n += 1 + sovQueries(uint64(m.OriginDomain))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAnnouncedStorageLocationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StorageLocations) > 0 {
+		for _, e := range m.StorageLocations {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AnnouncedStorageLocations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locations) > 0 {
+		for _, s := range m.Locations {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCheckpointSigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointSigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func sovQueries(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueries(x uint64) (n int) {
+	return sovQueries(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDutySetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutySetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutySetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyWithMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyWithMetadata = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MissingMetadata = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinVotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= DutySetOrder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDomain", wireType)
+			}
+			m.OriginDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutyValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutyValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutyValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointStorageUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointStorageUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightBps", wireType)
+			}
+			m.WeightBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainConfigs = append(m.DomainConfigs, &DomainCheckpointConfig{})
+			if err := m.DomainConfigs[len(m.DomainConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &DutyValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumNum", wireType)
+			}
+			m.QuorumNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumDen", wireType)
+			}
+			m.QuorumDen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumDen |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumMode", wireType)
+			}
+			m.QuorumMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumMode |= QuorumMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDutySetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDutySetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDutySetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDutySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDutySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDutySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &DutyValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumNum", wireType)
+			}
+			m.QuorumNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumDen", wireType)
+			}
+			m.QuorumDen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumDen |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochHeight", wireType)
+			}
+			m.NextEpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpochHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumMode", wireType)
+			}
+			m.QuorumMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumMode |= QuorumMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutyMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutyMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutyMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutyMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutyMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutyMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &DutyMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutySetSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutySetSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutySetSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &DutyValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumNum", wireType)
+			}
+			m.QuorumNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumDen", wireType)
+			}
+			m.QuorumDen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumDen |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetHash = append(m.SetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SetHash == nil {
+				m.SetHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumMode", wireType)
+			}
+			m.QuorumMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumMode |= QuorumMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutySetAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutySetAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutySetAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutySetAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutySetAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutySetAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snapshot == nil {
+				m.Snapshot = &DutySetSnapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutySetByEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutySetByEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutySetByEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutySetByEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutySetByEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutySetByEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snapshot == nil {
+				m.Snapshot = &DutySetSnapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDomain", wireType)
+			}
+			m.OriginDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, &Checkpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutyValidatorByCheckpointKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutyValidatorByCheckpointKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutyValidatorByCheckpointKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutyValidatorByCheckpointKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutyValidatorByCheckpointKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutyValidatorByCheckpointKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &DutyMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutyMetadataByOperatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutyMetadataByOperatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutyMetadataByOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutyMetadataByOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutyMetadataByOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutyMetadataByOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &DutyMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutyValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutyValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutyValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutyValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutyValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutyValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &DutyMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutySetHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutySetHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutySetHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidMetadataPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidMetadataPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingMetadataPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingMetadataPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidMetadataPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidMetadataPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumThresholdPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumThresholdPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumAchievable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumAchievable = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidMetadataCount", wireType)
+			}
+			m.ValidMetadataCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidMetadataCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumNum", wireType)
+			}
+			m.QuorumNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumDen", wireType)
+			}
+			m.QuorumDen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumDen |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumMode", wireType)
+			}
+			m.QuorumMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumMode |= QuorumMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumThresholdCount", wireType)
+			}
+			m.QuorumThresholdCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumThresholdCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutySetHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutySetHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutySetHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutySetHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutySetHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutySetHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &DutySetHealth{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArchivedDutyMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArchivedDutyMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArchivedDutyMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArchivedDutyMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArchivedDutyMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArchivedDutyMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tombstone == nil {
+				m.Tombstone = &DutyMetadataTombstone{}
+			}
+			if err := m.Tombstone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArchivedDutyMetadataByCheckpointKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArchivedDutyMetadataByCheckpointKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArchivedDutyMetadataByCheckpointKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArchivedDutyMetadataByCheckpointKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArchivedDutyMetadataByCheckpointKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tombstones = append(m.Tombstones, &DutyMetadataTombstone{})
+			if err := m.Tombstones[len(m.Tombstones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusKeyMigrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusKeyMigrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusKeyMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusKeyMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusKeyMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusKeyMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigratedTo == nil {
+				m.MigratedTo = &ConsensusKeyMigration{}
+			}
+			if err := m.MigratedTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigratedFrom == nil {
+				m.MigratedFrom = &ConsensusKeyMigration{}
+			}
+			if err := m.MigratedFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutyNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutyNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutyNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutyNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutyNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutyNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Current == nil {
+				m.Current = &CheckpointKeyRecord{}
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pending == nil {
+				m.Pending = &CheckpointKeyRecord{}
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Previous == nil {
+				m.Previous = &CheckpointKeyRecord{}
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOriginDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOriginDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOriginDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			m.DomainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOriginDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOriginDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOriginDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Domain == nil {
+				m.Domain = &OriginDomain{}
+			}
+			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOriginDomainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOriginDomainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOriginDomainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOriginDomainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOriginDomainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOriginDomainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domains = append(m.Domains, &OriginDomain{})
+			if err := m.Domains[len(m.Domains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnouncedStorageLocationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnouncedStorageLocationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnouncedStorageLocationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDomain", wireType)
+			}
+			m.OriginDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnouncedStorageLocationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnouncedStorageLocationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnouncedStorageLocationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageLocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageLocations = append(m.StorageLocations, &AnnouncedStorageLocations{})
+			if err := m.StorageLocations[len(m.StorageLocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnnouncedStorageLocations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnnouncedStorageLocations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnnouncedStorageLocations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locations = append(m.Locations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointSigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointSigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointSigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointSigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointSigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointSigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &CheckpointSigningInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueries(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueries
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueries
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueries
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueries        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueries          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueries = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,114 @@
+package types
+
+import "bytes"
+
+// ScheduleKeyRotation makes newKey the pending default checkpoint key from
+// height on, replacing a rotation that is already pending. The current key
+// keeps signing until ActivatePendingKey promotes newKey at
+// activationHeight.
+func (m *DutyMetadata) ScheduleKeyRotation(newKey string, height, activationHeight int64) {
+	m.PendingKey = &CheckpointKeyRecord{
+		CheckpointPubKey: newKey,
+		ValidFromHeight:  height,
+		ActivationHeight: activationHeight,
+	}
+}
+
+// ActivatePendingKey promotes the pending key to the current key if its
+// activation height is reached. The replaced key becomes the previous key,
+// valid up to and including the activation height.
+func (m *DutyMetadata) ActivatePendingKey(height int64) bool {
+	if m.PendingKey == nil || m.PendingKey.ActivationHeight > height {
+		return false
+	}
+	pending := m.PendingKey
+	m.retireCurrentKey(pending.ActivationHeight)
+	m.CheckpointPubKey = pending.CheckpointPubKey
+	m.CheckpointKeyValidFrom = pending.ValidFromHeight
+	m.PendingKey = nil
+	return true
+}
+
+// SetCheckpointKey replaces the default checkpoint key at height without a
+// delay, cancelling any pending rotation. Setting the current key again
+// keeps its validity range and the pending rotation.
+func (m *DutyMetadata) SetCheckpointKey(key string, height int64) {
+	if sameCheckpointKey(m.CheckpointPubKey, key) {
+		m.CheckpointPubKey = key
+		return
+	}
+	m.retireCurrentKey(height)
+	m.CheckpointPubKey = key
+	m.CheckpointKeyValidFrom = height
+	m.PendingKey = nil
+}
+
+// BindCheckpointKey binds key as the default checkpoint key at height and
+// returns the height it activates at. The first key, or the current key
+// bound again, takes effect at once. Replacing a current key is scheduled
+// like a rotation, delay blocks later, so the current key is not retired
+// early.
+func (m *DutyMetadata) BindCheckpointKey(key string, height int64, delay uint64) int64 {
+	if m.CheckpointPubKey == "" || sameCheckpointKey(m.CheckpointPubKey, key) {
+		m.SetCheckpointKey(key, height)
+		return height
+	}
+	activationHeight := height + int64(delay)
+	m.ScheduleKeyRotation(key, height, activationHeight)
+	return activationHeight
+}
+
+func (m *DutyMetadata) retireCurrentKey(height int64) {
+	if m.CheckpointPubKey == "" {
+		return
+	}
+	m.PreviousKey = &CheckpointKeyRecord{
+		CheckpointPubKey: m.CheckpointPubKey,
+		ValidFromHeight:  m.CheckpointKeyValidFrom,
+		ValidUntilHeight: height,
+	}
+}
+
+// CurrentKey returns the current default checkpoint key and the height it
+// signs from, or nil if none is set.
+func (m DutyMetadata) CurrentKey() *CheckpointKeyRecord {
+	if m.CheckpointPubKey == "" {
+		return nil
+	}
+	return &CheckpointKeyRecord{
+		CheckpointPubKey: m.CheckpointPubKey,
+		ValidFromHeight:  m.CheckpointKeyValidFrom,
+	}
+}
+
+// SigningKeys returns the keys that sign checkpoints of originDomain: the
+// per-domain key if the domain overrides it, otherwise the current default
+// key and the pending key of a scheduled rotation.
+func (m DutyMetadata) SigningKeys(originDomain uint32) []string {
+	key, _ := m.CheckpointConfigFor(originDomain)
+	keys := []string{key}
+	if key == m.CheckpointPubKey && m.PendingKey != nil {
+		keys = append(keys, m.PendingKey.CheckpointPubKey)
+	}
+	return keys
+}
+
+// IsRetiredKey reports whether key is the previous default key and stopped
+// signing before height.
+func (m DutyMetadata) IsRetiredKey(key string, height int64) bool {
+	return m.PreviousKey != nil &&
+		m.PreviousKey.ValidUntilHeight < height &&
+		sameCheckpointKey(m.PreviousKey.CheckpointPubKey, key) &&
+		!sameCheckpointKey(m.CheckpointPubKey, key)
+}
+
+// sameCheckpointKey reports whether a and b resolve to the same checkpoint
+// address. Keys that do not parse only match themselves.
+func sameCheckpointKey(a, b string) bool {
+	if a == b {
+		return true
+	}
+	addrA, errA := CheckpointAddress(a)
+	addrB, errB := CheckpointAddress(b)
+	return errA == nil && errB == nil && bytes.Equal(addrA, addrB)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutyMetadata_KeyRotation(t *testing.T) {
+	newKey := func() string {
+		priv, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		return "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	}
+	keyA, keyB, keyC, domainKey := newKey(), newKey(), newKey(), newKey()
+
+	meta := DutyMetadata{
+		DomainConfigs: []*DomainCheckpointConfig{{OriginDomain: 2, CheckpointPubKey: domainKey}},
+	}
+	meta.SetCheckpointKey(keyA, 5)
+	assert.Nil(t, meta.PreviousKey)
+	assert.Equal(t, &CheckpointKeyRecord{CheckpointPubKey: keyA, ValidFromHeight: 5}, meta.CurrentKey())
+
+	// Both keys sign for the default domains while the rotation is pending
+	meta.ScheduleKeyRotation(keyB, 10, 20)
+	assert.Equal(t, []string{keyA, keyB}, meta.SigningKeys(1))
+	assert.Equal(t, []string{domainKey}, meta.SigningKeys(2))
+	assert.Contains(t, meta.CheckpointPubKeys(), keyB)
+
+	assert.False(t, meta.ActivatePendingKey(19))
+	assert.True(t, meta.ActivatePendingKey(20))
+	assert.Nil(t, meta.PendingKey)
+	assert.Equal(t, &CheckpointKeyRecord{CheckpointPubKey: keyB, ValidFromHeight: 10}, meta.CurrentKey())
+	assert.Equal(t, &CheckpointKeyRecord{CheckpointPubKey: keyA, ValidFromHeight: 5, ValidUntilHeight: 20}, meta.PreviousKey)
+	assert.Equal(t, []string{keyB}, meta.SigningKeys(1))
+
+	// The previous key signs up to and including its last height
+	assert.False(t, meta.IsRetiredKey(keyA, 20))
+	assert.True(t, meta.IsRetiredKey(keyA, 21))
+	assert.False(t, meta.IsRetiredKey(keyB, 21))
+
+	// An immediate key change cancels a pending rotation; setting the
+	// current key again changes nothing
+	meta.ScheduleKeyRotation(keyC, 30, 40)
+	meta.SetCheckpointKey(keyB, 31)
+	assert.NotNil(t, meta.PendingKey)
+	meta.SetCheckpointKey(keyC, 32)
+	assert.Nil(t, meta.PendingKey)
+	assert.Equal(t, &CheckpointKeyRecord{CheckpointPubKey: keyB, ValidFromHeight: 10, ValidUntilHeight: 32}, meta.PreviousKey)
+	assert.Equal(t, int64(32), meta.CheckpointKeyValidFrom)
+}
+
+func TestDutyMetadata_BindCheckpointKey(t *testing.T) {
+	newKey := func() string {
+		priv, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+		return "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	}
+	keyA, keyB := newKey(), newKey()
+
+	// The first key takes effect at once
+	var meta DutyMetadata
+	assert.Equal(t, int64(5), meta.BindCheckpointKey(keyA, 5, 100))
+	assert.Equal(t, keyA, meta.CheckpointPubKey)
+	assert.Nil(t, meta.PendingKey)
+
+	// Replacing it waits for the rotation delay
+	assert.Equal(t, int64(110), meta.BindCheckpointKey(keyB, 10, 100))
+	assert.Equal(t, keyA, meta.CheckpointPubKey)
+	assert.Equal(t, &CheckpointKeyRecord{CheckpointPubKey: keyB, ValidFromHeight: 10, ActivationHeight: 110}, meta.PendingKey)
+	assert.False(t, meta.IsRetiredKey(keyA, 11))
+
+	// Binding the current key again keeps the pending rotation
+	assert.Equal(t, int64(12), meta.BindCheckpointKey(keyA, 12, 100))
+	assert.NotNil(t, meta.PendingKey)
+}
@@ -0,0 +1,216 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+// Domain separators for the payloads signed by checkpoint keys. Every payload
+// starts with one of these so a signature can never be replayed as another
+// kind of duty attestation.
+const (
+	RotateCheckpointKeyDomain = "duty/v1/rotate_checkpoint_key"
+	BindCheckpointKeyDomain   = "duty/v1/bind_checkpoint_key"
+	BindConsensusKeyDomain    = "duty/v1/bind_consensus_key"
+)
+
+// RotateCheckpointKeyPayload returns the bytes the new checkpoint key must sign
+// (EIP-191 personal_sign) to attest a rotation away from oldKey. nonce is the
+// validator's duty nonce.
+func RotateCheckpointKeyPayload(chainID string, consAddr []byte, oldKey, newKey string, nonce uint64) []byte {
+	var bz []byte
+	bz = appendLengthPrefixed(bz, []byte(RotateCheckpointKeyDomain))
+	bz = appendLengthPrefixed(bz, []byte(chainID))
+	bz = appendLengthPrefixed(bz, consAddr)
+	bz = appendLengthPrefixed(bz, []byte(oldKey))
+	bz = appendLengthPrefixed(bz, []byte(newKey))
+	return binary.BigEndian.AppendUint64(bz, nonce)
+}
+
+// BindCheckpointKeyPayload returns the bytes the checkpoint key must sign
+// (EIP-191 personal_sign) to claim the validator with consAddr for
+// originDomain, zero for the default key, at the given duty nonce.
+func BindCheckpointKeyPayload(chainID string, consAddr []byte, originDomain uint32, nonce uint64) []byte {
+	var bz []byte
+	bz = appendLengthPrefixed(bz, []byte(BindCheckpointKeyDomain))
+	bz = appendLengthPrefixed(bz, []byte(chainID))
+	bz = appendLengthPrefixed(bz, consAddr)
+	bz = binary.BigEndian.AppendUint32(bz, originDomain)
+	return binary.BigEndian.AppendUint64(bz, nonce)
+}
+
+// BindConsensusKeyPayload returns the bytes the validator's consensus key must
+// sign to claim checkpointKey for originDomain, zero for the default key, at
+// the given duty nonce.
+func BindConsensusKeyPayload(chainID string, checkpointKey string, originDomain uint32, nonce uint64) []byte {
+	var bz []byte
+	bz = appendLengthPrefixed(bz, []byte(BindConsensusKeyDomain))
+	bz = appendLengthPrefixed(bz, []byte(chainID))
+	bz = appendLengthPrefixed(bz, []byte(checkpointKey))
+	bz = binary.BigEndian.AppendUint32(bz, originDomain)
+	return binary.BigEndian.AppendUint64(bz, nonce)
+}
+
+func appendLengthPrefixed(bz, field []byte) []byte {
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(field)))
+	return append(bz, field...)
+}
+
+// Keccak256 returns the legacy Keccak-256 digest used by Ethereum.
+func Keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// EthSignedMessageHash returns the EIP-191 (version 0x45) digest of payload,
+// i.e. what eth_sign / personal_sign produce a signature over.
+func EthSignedMessageHash(payload []byte) []byte {
+	prefix := fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(payload))
+	return Keccak256([]byte(prefix), payload)
+}
+
+// DecodeHex decodes a hex string with an optional 0x prefix.
+func DecodeHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	return hex.DecodeString(s)
+}
+
+// NormalizeHex returns s lower-cased with a single 0x prefix.
+func NormalizeHex(s string) string {
+	return "0x" + strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+}
+
+// ParseCheckpointPubKey parses a hex encoded (compressed or uncompressed)
+// secp256k1 public key.
+func ParseCheckpointPubKey(s string) (*secp256k1.PublicKey, error) {
+	bz, err := DecodeHex(s)
+	if err != nil {
+		return nil, ErrInvalidCheckpointKey.Wrapf("not hex: %s", err)
+	}
+	pk, err := secp256k1.ParsePubKey(bz)
+	if err != nil {
+		return nil, ErrInvalidCheckpointKey.Wrap(err.Error())
+	}
+	return pk, nil
+}
+
+// EthAddress returns the 20-byte Ethereum address of a secp256k1 public key.
+func EthAddress(pk *secp256k1.PublicKey) []byte {
+	return Keccak256(pk.SerializeUncompressed()[1:])[12:]
+}
+
+// CheckpointAddress returns the 20-byte Ethereum address of a hex encoded
+// checkpoint key, which is either a secp256k1 public key or the address
+// itself.
+func CheckpointAddress(key string) ([]byte, error) {
+	bz, err := DecodeHex(key)
+	if err != nil {
+		return nil, ErrInvalidCheckpointKey.Wrapf("not hex: %s", err)
+	}
+	if len(bz) == 20 {
+		return bz, nil
+	}
+	pk, err := ParseCheckpointPubKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return EthAddress(pk), nil
+}
+
+// NormalizeCheckpointKey parses a checkpoint key and returns its canonical
+// form: a compressed public key in lower-case hex, or an EIP-55 checksummed
+// address. A mixed-case address must carry a valid checksum.
+func NormalizeCheckpointKey(key string) (string, error) {
+	raw := strings.TrimPrefix(strings.TrimPrefix(key, "0x"), "0X")
+	bz, err := hex.DecodeString(raw)
+	if err != nil {
+		return "", ErrInvalidCheckpointKey.Wrapf("not hex: %s", err)
+	}
+	if len(bz) == 20 {
+		checksummed := ChecksumAddress(bz)
+		if raw != strings.ToLower(raw) && raw != strings.ToUpper(raw) && raw != checksummed[2:] {
+			return "", ErrInvalidCheckpointKey.Wrapf("bad EIP-55 checksum in %s", key)
+		}
+		return checksummed, nil
+	}
+	pk, err := ParseCheckpointPubKey(key)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(pk.SerializeCompressed()), nil
+}
+
+// ChecksumAddress returns the EIP-55 mixed-case hex form of a 20-byte
+// address.
+func ChecksumAddress(addr []byte) string {
+	out := []byte(hex.EncodeToString(addr))
+	hash := Keccak256(out)
+	for i, c := range out {
+		// Letters are upper-cased where the matching hash nibble is >= 8
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0xf
+		}
+		if c >= 'a' && nibble >= 8 {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(out)
+}
+
+// RecoverEthAddress recovers the signer address from a 65-byte [R || S || V]
+// Ethereum signature over hash. V may be 0/1 or 27/28.
+func RecoverEthAddress(hash, sig []byte) ([]byte, error) {
+	if len(sig) != 65 {
+		return nil, fmt.Errorf("signature must be 65 bytes, got %d", len(sig))
+	}
+	v := sig[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return nil, fmt.Errorf("invalid recovery id %d", sig[64])
+	}
+
+	// decred expects [27 + recid || R || S]
+	compact := make([]byte, 65)
+	compact[0] = 27 + v
+	copy(compact[1:], sig[:64])
+
+	pk, _, err := ecdsa.RecoverCompact(compact, hash)
+	if err != nil {
+		return nil, err
+	}
+	return EthAddress(pk), nil
+}
+
+// VerifyCheckpointSignature checks that sigHex is an EIP-191 signature over
+// payload produced by the checkpoint key, a secp256k1 public key or its
+// address.
+func VerifyCheckpointSignature(key string, payload []byte, sigHex string) error {
+	expected, err := CheckpointAddress(key)
+	if err != nil {
+		return err
+	}
+	sig, err := DecodeHex(sigHex)
+	if err != nil {
+		return ErrInvalidAttestation.Wrapf("signature not hex: %s", err)
+	}
+	recovered, err := RecoverEthAddress(EthSignedMessageHash(payload), sig)
+	if err != nil {
+		return ErrInvalidAttestation.Wrap(err.Error())
+	}
+	if string(recovered) != string(expected) {
+		return ErrInvalidAttestation.Wrapf("recovered signer 0x%x does not match key 0x%x", recovered, expected)
+	}
+	return nil
+}
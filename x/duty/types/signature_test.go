@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signEthMessage produces a hex [R || S || V] personal_sign signature.
+func signEthMessage(t *testing.T, priv *secp256k1.PrivateKey, payload []byte) string {
+	t.Helper()
+	compact := ecdsa.SignCompact(priv, EthSignedMessageHash(payload), false)
+	sig := append(compact[1:], compact[0]) // move V to the end, keep 27/28
+	return "0x" + hex.EncodeToString(sig)
+}
+
+func TestVerifyCheckpointSignature(t *testing.T) {
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	pubKey := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+
+	payload := RotateCheckpointKeyPayload("duty-1", []byte("cons"), "0xold", pubKey, 0)
+	sig := signEthMessage(t, priv, payload)
+
+	// Valid signature from the new key
+	assert.NoError(t, VerifyCheckpointSignature(pubKey, payload, sig))
+
+	// Same signature over a different nonce is rejected
+	replayed := RotateCheckpointKeyPayload("duty-1", []byte("cons"), "0xold", pubKey, 1)
+	assert.ErrorIs(t, VerifyCheckpointSignature(pubKey, replayed, sig), ErrInvalidAttestation)
+
+	// Signature from another key is rejected
+	other, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	assert.ErrorIs(t, VerifyCheckpointSignature(pubKey, payload, signEthMessage(t, other, payload)), ErrInvalidAttestation)
+
+	// Malformed input
+	assert.ErrorIs(t, VerifyCheckpointSignature("0xzz", payload, sig), ErrInvalidCheckpointKey)
+	assert.ErrorIs(t, VerifyCheckpointSignature(pubKey, payload, "0x1234"), ErrInvalidAttestation)
+}
+
+func TestNormalizeCheckpointKey(t *testing.T) {
+	priv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	compressed := "0x" + hex.EncodeToString(priv.PubKey().SerializeCompressed())
+	uncompressed := hex.EncodeToString(priv.PubKey().SerializeUncompressed())
+
+	// Public keys become compressed lower-case hex with a 0x prefix
+	key, err := NormalizeCheckpointKey(uncompressed)
+	require.NoError(t, err)
+	assert.Equal(t, compressed, key)
+
+	// Addresses become EIP-55 checksummed, whatever the input case
+	const checksummed = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	for _, in := range []string{checksummed, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED"} {
+		key, err := NormalizeCheckpointKey(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, checksummed, key)
+	}
+
+	// Mixed case must be a valid checksum
+	_, err = NormalizeCheckpointKey("0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	assert.ErrorIs(t, err, ErrInvalidCheckpointKey)
+	_, err = NormalizeCheckpointKey("0x1234")
+	assert.ErrorIs(t, err, ErrInvalidCheckpointKey)
+
+	// Signatures verify against an address as well as a public key
+	payload := BindCheckpointKeyPayload("duty-1", []byte("cons"), 0, 0)
+	sig := signEthMessage(t, priv, payload)
+	assert.NoError(t, VerifyCheckpointSignature("0x"+hex.EncodeToString(EthAddress(priv.PubKey())), payload, sig))
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+// TotalWeightBps is the sum of the weights of a duty set.
+const TotalWeightBps = 10000
+
+// ComputeDutySetHash returns a commitment to the ordered validator set (with
+// voting power and checkpoint keys) and the quorum parameters. Tokens and
+// weights are left out: tokens move with every reward without changing
+// consensus power, and weights are derived from power. The quorum mode and
+// per-domain key overrides are only committed to when set, so hashes of sets
+// without them are the same as before they existed.
+func ComputeDutySetHash(validators []*DutyValidator, quorumNum, quorumDen uint32, mode QuorumMode) []byte {
+	var bz []byte
+	bz = binary.BigEndian.AppendUint32(bz, quorumNum)
+	bz = binary.BigEndian.AppendUint32(bz, quorumDen)
+	if mode != QuorumMode_QUORUM_MODE_POWER {
+		bz = binary.BigEndian.AppendUint32(bz, uint32(mode))
+	}
+	for _, v := range validators {
+		bz = appendLengthPrefixed(bz, []byte(v.ValConsAddr))
+		bz = appendLengthPrefixed(bz, []byte(v.VotingPower))
+		bz = appendLengthPrefixed(bz, []byte(v.CheckpointPubKey))
+		bz = appendLengthPrefixed(bz, []byte(v.CheckpointStorageUri))
+	}
+	for _, v := range validators {
+		if len(v.DomainConfigs) == 0 {
+			continue
+		}
+		bz = appendLengthPrefixed(bz, []byte("domain_configs"))
+		bz = appendLengthPrefixed(bz, []byte(v.ValConsAddr))
+		bz = binary.BigEndian.AppendUint32(bz, uint32(len(v.DomainConfigs)))
+		for _, c := range v.DomainConfigs {
+			bz = binary.BigEndian.AppendUint32(bz, c.OriginDomain)
+			bz = appendLengthPrefixed(bz, []byte(c.CheckpointPubKey))
+			bz = appendLengthPrefixed(bz, []byte(c.CheckpointStorageUri))
+		}
+	}
+	sum := sha256.Sum256(bz)
+	return sum[:]
+}
+
+// TotalVotingPower sums the voting power of validators, skipping entries with
+// a malformed power.
+func TotalVotingPower(validators []*DutyValidator) math.Int {
+	total := math.ZeroInt()
+	for _, v := range validators {
+		if power, ok := math.NewIntFromString(v.VotingPower); ok {
+			total = total.Add(power)
+		}
+	}
+	return total
+}
+
+// AssignWeights sets WeightBps on every validator to its share of the total
+// voting power in basis points. Shares are rounded down and the remaining
+// basis points go to the largest remainders (earlier entries win ties), so
+// the weights sum to exactly TotalWeightBps. If the set has no power, every
+// weight is zero.
+func AssignWeights(validators []*DutyValidator) {
+	total := TotalVotingPower(validators)
+	if !total.IsPositive() {
+		for _, v := range validators {
+			v.WeightBps = 0
+		}
+		return
+	}
+
+	remainders := make([]math.Int, len(validators))
+	assigned := uint32(0)
+	for i, v := range validators {
+		power, ok := math.NewIntFromString(v.VotingPower)
+		if !ok || power.IsNegative() {
+			power = math.ZeroInt()
+		}
+		scaled := power.MulRaw(TotalWeightBps)
+		v.WeightBps = uint32(scaled.Quo(total).Uint64())
+		remainders[i] = scaled.Mod(total)
+		assigned += v.WeightBps
+	}
+
+	order := make([]int, len(validators))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].GT(remainders[order[b]])
+	})
+	for _, i := range order[:TotalWeightBps-assigned] {
+		validators[i].WeightBps++
+	}
+}
+
+// QuorumThreshold returns the concrete quorum threshold of the set: the
+// number of signatures needed in count mode, or the least signed voting power
+// in power mode. Both round up.
+func (s DutySetSnapshot) QuorumThreshold() math.Int {
+	if s.QuorumMode == QuorumMode_QUORUM_MODE_COUNT {
+		return math.NewInt(int64(QuorumCountThreshold(uint32(len(s.Validators)), s.QuorumNum, s.QuorumDen)))
+	}
+	return QuorumPowerThreshold(TotalVotingPower(s.Validators), s.QuorumNum, s.QuorumDen)
+}
+
+// QuorumReachedBy reports whether signers validators of the set, together
+// holding signedPower, reach its quorum under the set's quorum mode.
+func (s DutySetSnapshot) QuorumReachedBy(signedPower math.Int, signers int) bool {
+	if s.QuorumMode == QuorumMode_QUORUM_MODE_COUNT {
+		return QuorumReached(math.NewInt(int64(signers)), math.NewInt(int64(len(s.Validators))), s.QuorumNum, s.QuorumDen)
+	}
+	return QuorumReached(signedPower, TotalVotingPower(s.Validators), s.QuorumNum, s.QuorumDen)
+}
+
+// FindValidator returns the entry for valConsAddr, if present.
+func (s DutySetSnapshot) FindValidator(valConsAddr string) (*DutyValidator, bool) {
+	for _, v := range s.Validators {
+		if v.ValConsAddr == valConsAddr {
+			return v, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignWeights(t *testing.T) {
+	sum := func(vs []*DutyValidator) uint32 {
+		var total uint32
+		for _, v := range vs {
+			total += v.WeightBps
+		}
+		return total
+	}
+
+	// Exact shares
+	vs := []*DutyValidator{{VotingPower: "60"}, {VotingPower: "30"}, {VotingPower: "10"}}
+	AssignWeights(vs)
+	assert.Equal(t, []uint32{6000, 3000, 1000}, []uint32{vs[0].WeightBps, vs[1].WeightBps, vs[2].WeightBps})
+
+	// Thirds: the leftover basis point goes to the first entry
+	vs = []*DutyValidator{{VotingPower: "1"}, {VotingPower: "1"}, {VotingPower: "1"}}
+	AssignWeights(vs)
+	assert.Equal(t, []uint32{3334, 3333, 3333}, []uint32{vs[0].WeightBps, vs[1].WeightBps, vs[2].WeightBps})
+
+	// Largest remainder wins, and the total is always 10000
+	vs = []*DutyValidator{{VotingPower: "1"}, {VotingPower: "2"}, {VotingPower: "3"}}
+	AssignWeights(vs)
+	assert.Equal(t, []uint32{1667, 3333, 5000}, []uint32{vs[0].WeightBps, vs[1].WeightBps, vs[2].WeightBps})
+	assert.Equal(t, uint32(TotalWeightBps), sum(vs))
+
+	// No power: no weight
+	vs = []*DutyValidator{{VotingPower: "0"}, {VotingPower: "0"}}
+	AssignWeights(vs)
+	assert.Equal(t, uint32(0), sum(vs))
+}
+
+func TestDutySetSnapshot_QuorumThreshold(t *testing.T) {
+	snap := DutySetSnapshot{
+		Validators: []*DutyValidator{{VotingPower: "70"}, {VotingPower: "10"}, {VotingPower: "10"}, {VotingPower: "10"}},
+		QuorumNum:  2,
+		QuorumDen:  3,
+	}
+
+	// Power mode: ceil(100 * 2/3)
+	assert.Equal(t, math.NewInt(67), snap.QuorumThreshold())
+	assert.True(t, snap.QuorumReachedBy(math.NewInt(70), 1))
+	assert.False(t, snap.QuorumReachedBy(math.NewInt(30), 3))
+
+	// Count mode: ceil(4 * 2/3), whatever the power
+	snap.QuorumMode = QuorumMode_QUORUM_MODE_COUNT
+	assert.Equal(t, math.NewInt(3), snap.QuorumThreshold())
+	assert.False(t, snap.QuorumReachedBy(math.NewInt(70), 1))
+	assert.True(t, snap.QuorumReachedBy(math.NewInt(30), 3))
+
+	// A single validator is its own quorum
+	single := DutySetSnapshot{Validators: snap.Validators[:1], QuorumNum: 2, QuorumDen: 3, QuorumMode: QuorumMode_QUORUM_MODE_COUNT}
+	assert.Equal(t, math.NewInt(1), single.QuorumThreshold())
+	assert.True(t, single.QuorumReachedBy(math.NewInt(70), 1))
+}
+
+func TestComputeDutySetHash_QuorumMode(t *testing.T) {
+	vs := []*DutyValidator{{ValConsAddr: "a", VotingPower: "1"}}
+	power := ComputeDutySetHash(vs, 2, 3, QuorumMode_QUORUM_MODE_POWER)
+	count := ComputeDutySetHash(vs, 2, 3, QuorumMode_QUORUM_MODE_COUNT)
+	assert.NotEqual(t, power, count)
+}
@@ -1,8 +1,5 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.28.1
-// 	protoc        v3.21.12
-// source: proto/duty/v1/tx.proto
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: duty/v1/tx.proto
 
 package types
 
@@ -10,567 +7,6466 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	reflect "reflect"
-	sync "sync"
+	io "io"
+	math "math"
+	math_bits "math/bits"
 )
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgSetDutyMetadata defines the SetDutyMetadata message
 type MsgSetDutyMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
 	// signer is the consensus validator operator address (valoper...)
-	Signer   string        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Metadata *DutyMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// metadata contains the storage URIs to set. Its checkpoint keys, if
+	// given, must match the registered ones: keys only change through
+	// MsgRotateCheckpointKey and MsgBindCheckpointKey.
+	Metadata DutyMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+	// announcements optionally announce the storage location used for origin
+	// domains, as Hyperlane's ValidatorAnnounce contract would
+	Announcements []*ValidatorAnnouncement `protobuf:"bytes,3,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	// nonce is the validator's current duty nonce
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *MsgSetDutyMetadata) Reset() {
-	*x = MsgSetDutyMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_duty_v1_tx_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *MsgSetDutyMetadata) Reset()         { *m = MsgSetDutyMetadata{} }
+func (m *MsgSetDutyMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDutyMetadata) ProtoMessage()    {}
+func (*MsgSetDutyMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{0}
+}
+func (m *MsgSetDutyMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDutyMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDutyMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
 }
-
-func (x *MsgSetDutyMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *MsgSetDutyMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDutyMetadata.Merge(m, src)
+}
+func (m *MsgSetDutyMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDutyMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDutyMetadata.DiscardUnknown(m)
 }
 
-func (*MsgSetDutyMetadata) ProtoMessage() {}
+var xxx_messageInfo_MsgSetDutyMetadata proto.InternalMessageInfo
 
-func (x *MsgSetDutyMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_duty_v1_tx_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *MsgSetDutyMetadata) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MsgSetDutyMetadata.ProtoReflect.Descriptor instead.
-func (*MsgSetDutyMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_duty_v1_tx_proto_rawDescGZIP(), []int{0}
+func (m *MsgSetDutyMetadata) GetMetadata() DutyMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return DutyMetadata{}
 }
 
-func (x *MsgSetDutyMetadata) GetSigner() string {
-	if x != nil {
-		return x.Signer
+func (m *MsgSetDutyMetadata) GetAnnouncements() []*ValidatorAnnouncement {
+	if m != nil {
+		return m.Announcements
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgSetDutyMetadata) GetMetadata() *DutyMetadata {
-	if x != nil {
-		return x.Metadata
+func (m *MsgSetDutyMetadata) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return nil
+	return 0
 }
 
 // MsgRotateCheckpointKey defines the RotateCheckpointKey message
 type MsgRotateCheckpointKey struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
 	// signer is the consensus validator operator address (valoper...)
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// new_checkpoint_pub_key is the new ECDSA secp256k1 public key
 	NewCheckpointPubKey string `protobuf:"bytes,2,opt,name=new_checkpoint_pub_key,json=newCheckpointPubKey,proto3" json:"new_checkpoint_pub_key,omitempty"`
-	// attestation_signature is a signature proving ownership of the new key
+	// attestation_signature is a 65-byte [R || S || V] EIP-191 signature by the
+	// new key over the rotation payload (chain ID, consensus address, old key,
+	// new key, duty nonce), hex encoded
 	AttestationSignature string `protobuf:"bytes,3,opt,name=attestation_signature,json=attestationSignature,proto3" json:"attestation_signature,omitempty"`
+	// nonce is the validator's current duty nonce
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *MsgRotateCheckpointKey) Reset() {
-	*x = MsgRotateCheckpointKey{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_duty_v1_tx_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *MsgRotateCheckpointKey) Reset()         { *m = MsgRotateCheckpointKey{} }
+func (m *MsgRotateCheckpointKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateCheckpointKey) ProtoMessage()    {}
+func (*MsgRotateCheckpointKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{1}
 }
-
-func (x *MsgRotateCheckpointKey) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *MsgRotateCheckpointKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*MsgRotateCheckpointKey) ProtoMessage() {}
-
-func (x *MsgRotateCheckpointKey) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_duty_v1_tx_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+func (m *MsgRotateCheckpointKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateCheckpointKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return ms
+		return b[:n], nil
 	}
-	return mi.MessageOf(x)
 }
-
-// Deprecated: Use MsgRotateCheckpointKey.ProtoReflect.Descriptor instead.
-func (*MsgRotateCheckpointKey) Descriptor() ([]byte, []int) {
-	return file_proto_duty_v1_tx_proto_rawDescGZIP(), []int{1}
+func (m *MsgRotateCheckpointKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateCheckpointKey.Merge(m, src)
+}
+func (m *MsgRotateCheckpointKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateCheckpointKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateCheckpointKey.DiscardUnknown(m)
 }
 
-func (x *MsgRotateCheckpointKey) GetSigner() string {
-	if x != nil {
-		return x.Signer
+var xxx_messageInfo_MsgRotateCheckpointKey proto.InternalMessageInfo
+
+func (m *MsgRotateCheckpointKey) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
 	return ""
 }
 
-func (x *MsgRotateCheckpointKey) GetNewCheckpointPubKey() string {
-	if x != nil {
-		return x.NewCheckpointPubKey
+func (m *MsgRotateCheckpointKey) GetNewCheckpointPubKey() string {
+	if m != nil {
+		return m.NewCheckpointPubKey
 	}
 	return ""
 }
 
-func (x *MsgRotateCheckpointKey) GetAttestationSignature() string {
-	if x != nil {
-		return x.AttestationSignature
+func (m *MsgRotateCheckpointKey) GetAttestationSignature() string {
+	if m != nil {
+		return m.AttestationSignature
 	}
 	return ""
 }
 
+func (m *MsgRotateCheckpointKey) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // MsgBindCheckpointKey defines the BindCheckpointKey message
 type MsgBindCheckpointKey struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
 	// signer is the consensus validator operator address (valoper...)
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// checkpoint_pub_key is the ECDSA secp256k1 public key to bind
 	CheckpointPubKey string `protobuf:"bytes,2,opt,name=checkpoint_pub_key,json=checkpointPubKey,proto3" json:"checkpoint_pub_key,omitempty"`
-	// binding_signature is a signature proving the binding
+	// binding_signature is a 65-byte [R || S || V] EIP-191 signature by the
+	// checkpoint key over the binding payload (chain ID, consensus address,
+	// duty nonce), hex encoded
 	BindingSignature string `protobuf:"bytes,3,opt,name=binding_signature,json=bindingSignature,proto3" json:"binding_signature,omitempty"`
 	// consensus_address is the consensus address to bind to
 	ConsensusAddress string `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// consensus_signature is a signature by the validator's consensus (ed25519)
+	// key over the binding payload (chain ID, checkpoint key, duty nonce), hex
+	// encoded
+	ConsensusSignature string `protobuf:"bytes,5,opt,name=consensus_signature,json=consensusSignature,proto3" json:"consensus_signature,omitempty"`
+	// nonce is the validator's current duty nonce
+	Nonce uint64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// origin_domain, if set, binds the key for that registered origin domain
+	// only, as its per-domain override; zero binds the default key
+	OriginDomain uint32 `protobuf:"varint,7,opt,name=origin_domain,json=originDomain,proto3" json:"origin_domain,omitempty"`
 }
 
-func (x *MsgBindCheckpointKey) Reset() {
-	*x = MsgBindCheckpointKey{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_duty_v1_tx_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *MsgBindCheckpointKey) Reset()         { *m = MsgBindCheckpointKey{} }
+func (m *MsgBindCheckpointKey) String() string { return proto.CompactTextString(m) }
+func (*MsgBindCheckpointKey) ProtoMessage()    {}
+func (*MsgBindCheckpointKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{2}
+}
+func (m *MsgBindCheckpointKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindCheckpointKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindCheckpointKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
 }
-
-func (x *MsgBindCheckpointKey) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *MsgBindCheckpointKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindCheckpointKey.Merge(m, src)
+}
+func (m *MsgBindCheckpointKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindCheckpointKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindCheckpointKey.DiscardUnknown(m)
 }
 
-func (*MsgBindCheckpointKey) ProtoMessage() {}
+var xxx_messageInfo_MsgBindCheckpointKey proto.InternalMessageInfo
 
-func (x *MsgBindCheckpointKey) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_duty_v1_tx_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *MsgBindCheckpointKey) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MsgBindCheckpointKey.ProtoReflect.Descriptor instead.
-func (*MsgBindCheckpointKey) Descriptor() ([]byte, []int) {
-	return file_proto_duty_v1_tx_proto_rawDescGZIP(), []int{2}
+func (m *MsgBindCheckpointKey) GetCheckpointPubKey() string {
+	if m != nil {
+		return m.CheckpointPubKey
+	}
+	return ""
 }
 
-func (x *MsgBindCheckpointKey) GetSigner() string {
-	if x != nil {
-		return x.Signer
+func (m *MsgBindCheckpointKey) GetBindingSignature() string {
+	if m != nil {
+		return m.BindingSignature
 	}
 	return ""
 }
 
-func (x *MsgBindCheckpointKey) GetCheckpointPubKey() string {
-	if x != nil {
-		return x.CheckpointPubKey
+func (m *MsgBindCheckpointKey) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
 	}
 	return ""
 }
 
-func (x *MsgBindCheckpointKey) GetBindingSignature() string {
-	if x != nil {
-		return x.BindingSignature
+func (m *MsgBindCheckpointKey) GetConsensusSignature() string {
+	if m != nil {
+		return m.ConsensusSignature
 	}
 	return ""
 }
 
-func (x *MsgBindCheckpointKey) GetConsensusAddress() string {
-	if x != nil {
-		return x.ConsensusAddress
+func (m *MsgBindCheckpointKey) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return ""
+	return 0
+}
+
+func (m *MsgBindCheckpointKey) GetOriginDomain() uint32 {
+	if m != nil {
+		return m.OriginDomain
+	}
+	return 0
 }
 
 // DutyMetadata contains the duty metadata for a validator
 type DutyMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
 	// checkpoint_pub_key is the ECDSA secp256k1 public key used to sign Hyperlane checkpoints
 	CheckpointPubKey string `protobuf:"bytes,1,opt,name=checkpoint_pub_key,json=checkpointPubKey,proto3" json:"checkpoint_pub_key,omitempty"`
 	// checkpoint_storage_uri is the public location for signatures
 	CheckpointStorageUri string `protobuf:"bytes,2,opt,name=checkpoint_storage_uri,json=checkpointStorageUri,proto3" json:"checkpoint_storage_uri,omitempty"`
+	// domain_configs optionally override the key and storage URI for
+	// individual origin domains, at most one entry per domain. The keys are
+	// set through MsgBindCheckpointKey.
+	DomainConfigs []*DomainCheckpointConfig `protobuf:"bytes,3,rep,name=domain_configs,json=domainConfigs,proto3" json:"domain_configs,omitempty"`
+	// Deprecated: announcements are no longer written here. The v6 migration
+	// moves them to the append-only storage announcement store.
+	Announcements []*StorageAnnouncement `protobuf:"bytes,4,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	// checkpoint_key_valid_from is the height from which checkpoint_pub_key
+	// signs checkpoints
+	CheckpointKeyValidFrom int64 `protobuf:"varint,5,opt,name=checkpoint_key_valid_from,json=checkpointKeyValidFrom,proto3" json:"checkpoint_key_valid_from,omitempty"`
+	// pending_key is a rotated checkpoint key waiting for its activation
+	// height. It signs alongside checkpoint_pub_key until EndBlock promotes it.
+	PendingKey *CheckpointKeyRecord `protobuf:"bytes,6,opt,name=pending_key,json=pendingKey,proto3" json:"pending_key,omitempty"`
+	// previous_key is the default checkpoint key replaced last, kept so
+	// signatures it made while valid can still be attributed
+	PreviousKey *CheckpointKeyRecord `protobuf:"bytes,7,opt,name=previous_key,json=previousKey,proto3" json:"previous_key,omitempty"`
 }
 
-func (x *DutyMetadata) Reset() {
-	*x = DutyMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_duty_v1_tx_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *DutyMetadata) Reset()         { *m = DutyMetadata{} }
+func (m *DutyMetadata) String() string { return proto.CompactTextString(m) }
+func (*DutyMetadata) ProtoMessage()    {}
+func (*DutyMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{3}
+}
+func (m *DutyMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutyMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutyMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
 }
+func (m *DutyMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutyMetadata.Merge(m, src)
+}
+func (m *DutyMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutyMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutyMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutyMetadata proto.InternalMessageInfo
 
-func (x *DutyMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *DutyMetadata) GetCheckpointPubKey() string {
+	if m != nil {
+		return m.CheckpointPubKey
+	}
+	return ""
 }
 
-func (*DutyMetadata) ProtoMessage() {}
+func (m *DutyMetadata) GetCheckpointStorageUri() string {
+	if m != nil {
+		return m.CheckpointStorageUri
+	}
+	return ""
+}
 
-func (x *DutyMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_duty_v1_tx_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *DutyMetadata) GetDomainConfigs() []*DomainCheckpointConfig {
+	if m != nil {
+		return m.DomainConfigs
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DutyMetadata.ProtoReflect.Descriptor instead.
-func (*DutyMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_duty_v1_tx_proto_rawDescGZIP(), []int{3}
+func (m *DutyMetadata) GetAnnouncements() []*StorageAnnouncement {
+	if m != nil {
+		return m.Announcements
+	}
+	return nil
 }
 
-func (x *DutyMetadata) GetCheckpointPubKey() string {
-	if x != nil {
-		return x.CheckpointPubKey
+func (m *DutyMetadata) GetCheckpointKeyValidFrom() int64 {
+	if m != nil {
+		return m.CheckpointKeyValidFrom
 	}
-	return ""
+	return 0
 }
 
-func (x *DutyMetadata) GetCheckpointStorageUri() string {
-	if x != nil {
-		return x.CheckpointStorageUri
+func (m *DutyMetadata) GetPendingKey() *CheckpointKeyRecord {
+	if m != nil {
+		return m.PendingKey
 	}
-	return ""
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SetDutyMetadata sets the duty metadata for a validator
-	SetDutyMetadata(context.Context, *MsgSetDutyMetadata) (*emptypb.Empty, error)
-	// RotateCheckpointKey rotates the checkpoint signing key for a validator
-	RotateCheckpointKey(context.Context, *MsgRotateCheckpointKey) (*emptypb.Empty, error)
-	// BindCheckpointKey creates a canonical binding between consensus validator and checkpoint key
-	BindCheckpointKey(context.Context, *MsgBindCheckpointKey) (*emptypb.Empty, error)
+func (m *DutyMetadata) GetPreviousKey() *CheckpointKeyRecord {
+	if m != nil {
+		return m.PreviousKey
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// CheckpointKeyRecord is a checkpoint key with the heights it signs for.
+type CheckpointKeyRecord struct {
+	CheckpointPubKey string `protobuf:"bytes,1,opt,name=checkpoint_pub_key,json=checkpointPubKey,proto3" json:"checkpoint_pub_key,omitempty"`
+	// valid_from_height is the first height the key signs for
+	ValidFromHeight int64 `protobuf:"varint,2,opt,name=valid_from_height,json=validFromHeight,proto3" json:"valid_from_height,omitempty"`
+	// valid_until_height is the last height the key signs for, zero while it
+	// is open-ended
+	ValidUntilHeight int64 `protobuf:"varint,3,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
+	// activation_height is the height at which a pending key becomes the
+	// current key, zero for other keys
+	ActivationHeight int64 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
-func (UnimplementedMsgServer) SetDutyMetadata(context.Context, *MsgSetDutyMetadata) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDutyMetadata not implemented")
+func (m *CheckpointKeyRecord) Reset()         { *m = CheckpointKeyRecord{} }
+func (m *CheckpointKeyRecord) String() string { return proto.CompactTextString(m) }
+func (*CheckpointKeyRecord) ProtoMessage()    {}
+func (*CheckpointKeyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{4}
 }
-func (UnimplementedMsgServer) RotateCheckpointKey(context.Context, *MsgRotateCheckpointKey) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RotateCheckpointKey not implemented")
+func (m *CheckpointKeyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (UnimplementedMsgServer) BindCheckpointKey(context.Context, *MsgBindCheckpointKey) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BindCheckpointKey not implemented")
+func (m *CheckpointKeyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointKeyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointKeyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointKeyRecord.Merge(m, src)
+}
+func (m *CheckpointKeyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointKeyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointKeyRecord.DiscardUnknown(m)
 }
 
-// MsgClient is the client API for Msg service.
-type MsgClient interface {
-	// SetDutyMetadata sets the duty metadata for a validator
-	SetDutyMetadata(ctx context.Context, in *MsgSetDutyMetadata, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// RotateCheckpointKey rotates the checkpoint signing key for a validator
-	RotateCheckpointKey(ctx context.Context, in *MsgRotateCheckpointKey, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// BindCheckpointKey creates a canonical binding between consensus validator and checkpoint key
-	BindCheckpointKey(ctx context.Context, in *MsgBindCheckpointKey, opts ...grpc.CallOption) (*emptypb.Empty, error)
+var xxx_messageInfo_CheckpointKeyRecord proto.InternalMessageInfo
+
+func (m *CheckpointKeyRecord) GetCheckpointPubKey() string {
+	if m != nil {
+		return m.CheckpointPubKey
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc.ClientConnInterface
+func (m *CheckpointKeyRecord) GetValidFromHeight() int64 {
+	if m != nil {
+		return m.ValidFromHeight
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
-	return &msgClient{cc}
+func (m *CheckpointKeyRecord) GetValidUntilHeight() int64 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
 }
 
-func (c *msgClient) SetDutyMetadata(ctx context.Context, in *MsgSetDutyMetadata, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/duty.v1.Msg/SetDutyMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointKeyRecord) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RotateCheckpointKey(ctx context.Context, in *MsgRotateCheckpointKey, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/duty.v1.Msg/RotateCheckpointKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+// DutyMetadataTombstone is the metadata of a validator removed from the
+// staking module, archived at removal so checkpoints it signed can still be
+// attributed after its keys are released.
+type DutyMetadataTombstone struct {
+	ValConsAddr string       `protobuf:"bytes,1,opt,name=val_cons_addr,json=valConsAddr,proto3" json:"val_cons_addr,omitempty"`
+	ValAddr     string       `protobuf:"bytes,2,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+	Metadata    DutyMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+	// removal_height is the height the validator was removed at
+	RemovalHeight int64 `protobuf:"varint,4,opt,name=removal_height,json=removalHeight,proto3" json:"removal_height,omitempty"`
+}
+
+func (m *DutyMetadataTombstone) Reset()         { *m = DutyMetadataTombstone{} }
+func (m *DutyMetadataTombstone) String() string { return proto.CompactTextString(m) }
+func (*DutyMetadataTombstone) ProtoMessage()    {}
+func (*DutyMetadataTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{5}
+}
+func (m *DutyMetadataTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutyMetadataTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutyMetadataTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DutyMetadataTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutyMetadataTombstone.Merge(m, src)
+}
+func (m *DutyMetadataTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutyMetadataTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutyMetadataTombstone.DiscardUnknown(m)
 }
 
-func (c *msgClient) BindCheckpointKey(ctx context.Context, in *MsgBindCheckpointKey, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/duty.v1.Msg/BindCheckpointKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DutyMetadataTombstone proto.InternalMessageInfo
+
+func (m *DutyMetadataTombstone) GetValConsAddr() string {
+	if m != nil {
+		return m.ValConsAddr
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-// All implementations must embed UnimplementedMsgServer
-// for forward compatibility
-type msgServer struct {
-	UnimplementedMsgServer
+func (m *DutyMetadataTombstone) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *DutyMetadataTombstone) GetMetadata() DutyMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return DutyMetadata{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "duty.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetDutyMetadata",
-			Handler:    _Msg_SetDutyMetadata_Handler,
-		},
-		{
-			MethodName: "RotateCheckpointKey",
-			Handler:    _Msg_RotateCheckpointKey_Handler,
-		},
-		{
-			MethodName: "BindCheckpointKey",
-			Handler:    _Msg_BindCheckpointKey_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/duty/v1/tx.proto",
+func (m *DutyMetadataTombstone) GetRemovalHeight() int64 {
+	if m != nil {
+		return m.RemovalHeight
+	}
+	return 0
 }
 
-func _Msg_SetDutyMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetDutyMetadata)
-	if err := dec(in); err != nil {
-		return nil, err
+// ConsensusKeyMigration records that a validator's consensus key was rotated
+// and its duty state moved from old_cons_addr to new_cons_addr.
+type ConsensusKeyMigration struct {
+	OldConsAddr string `protobuf:"bytes,1,opt,name=old_cons_addr,json=oldConsAddr,proto3" json:"old_cons_addr,omitempty"`
+	NewConsAddr string `protobuf:"bytes,2,opt,name=new_cons_addr,json=newConsAddr,proto3" json:"new_cons_addr,omitempty"`
+	// height is the height the migration happened at
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ConsensusKeyMigration) Reset()         { *m = ConsensusKeyMigration{} }
+func (m *ConsensusKeyMigration) String() string { return proto.CompactTextString(m) }
+func (*ConsensusKeyMigration) ProtoMessage()    {}
+func (*ConsensusKeyMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{6}
+}
+func (m *ConsensusKeyMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsensusKeyMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusKeyMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDutyMetadata(ctx, in)
+}
+func (m *ConsensusKeyMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusKeyMigration.Merge(m, src)
+}
+func (m *ConsensusKeyMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsensusKeyMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusKeyMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusKeyMigration proto.InternalMessageInfo
+
+func (m *ConsensusKeyMigration) GetOldConsAddr() string {
+	if m != nil {
+		return m.OldConsAddr
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/duty.v1.Msg/SetDutyMetadata",
+	return ""
+}
+
+func (m *ConsensusKeyMigration) GetNewConsAddr() string {
+	if m != nil {
+		return m.NewConsAddr
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDutyMetadata(ctx, req.(*MsgSetDutyMetadata))
+	return ""
+}
+
+func (m *ConsensusKeyMigration) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RotateCheckpointKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRotateCheckpointKey)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgSubmitCheckpointSignature defines the SubmitCheckpointSignature message
+type MsgSubmitCheckpointSignature struct {
+	// signer is the consensus validator operator address (valoper...)
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// origin_domain is the Hyperlane domain ID of the origin chain
+	OriginDomain uint32 `protobuf:"varint,2,opt,name=origin_domain,json=originDomain,proto3" json:"origin_domain,omitempty"`
+	// merkle_tree_hook is the origin merkle tree hook address (bytes32, hex)
+	MerkleTreeHook string `protobuf:"bytes,3,opt,name=merkle_tree_hook,json=merkleTreeHook,proto3" json:"merkle_tree_hook,omitempty"`
+	// root is the merkle root of the checkpoint (bytes32, hex)
+	Root string `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	// index is the merkle tree index of the checkpoint
+	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	// message_id is the ID of the message at index (bytes32, hex)
+	MessageId string `protobuf:"bytes,6,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// signature is the 65-byte [R || S || V] EIP-191 signature by the
+	// validator's checkpoint key over the Hyperlane checkpoint digest, hex encoded
+	Signature string `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgSubmitCheckpointSignature) Reset()         { *m = MsgSubmitCheckpointSignature{} }
+func (m *MsgSubmitCheckpointSignature) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitCheckpointSignature) ProtoMessage()    {}
+func (*MsgSubmitCheckpointSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{7}
+}
+func (m *MsgSubmitCheckpointSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitCheckpointSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitCheckpointSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RotateCheckpointKey(ctx, in)
+}
+func (m *MsgSubmitCheckpointSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitCheckpointSignature.Merge(m, src)
+}
+func (m *MsgSubmitCheckpointSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitCheckpointSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitCheckpointSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitCheckpointSignature proto.InternalMessageInfo
+
+func (m *MsgSubmitCheckpointSignature) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/duty.v1.Msg/RotateCheckpointKey",
+	return ""
+}
+
+func (m *MsgSubmitCheckpointSignature) GetOriginDomain() uint32 {
+	if m != nil {
+		return m.OriginDomain
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RotateCheckpointKey(ctx, req.(*MsgRotateCheckpointKey))
+	return 0
+}
+
+func (m *MsgSubmitCheckpointSignature) GetMerkleTreeHook() string {
+	if m != nil {
+		return m.MerkleTreeHook
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_BindCheckpointKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBindCheckpointKey)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSubmitCheckpointSignature) GetRoot() string {
+	if m != nil {
+		return m.Root
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BindCheckpointKey(ctx, in)
+	return ""
+}
+
+func (m *MsgSubmitCheckpointSignature) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/duty.v1.Msg/BindCheckpointKey",
+	return 0
+}
+
+func (m *MsgSubmitCheckpointSignature) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BindCheckpointKey(ctx, req.(*MsgBindCheckpointKey))
+	return ""
+}
+
+func (m *MsgSubmitCheckpointSignature) GetSignature() string {
+	if m != nil {
+		return m.Signature
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var File_proto_duty_v1_tx_proto protoreflect.FileDescriptor
-
-var file_proto_duty_v1_tx_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x75, 0x74, 0x79, 0x2f, 0x76, 0x31, 0x2f,
-	0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x64, 0x75, 0x74, 0x79, 0x2e, 0x76,
-	0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0x8a, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x4a, 0x0a, 0x0f,
-	0x53, 0x65, 0x74, 0x44, 0x75, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x1a, 0x2e, 0x64, 0x75, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
-	0x44, 0x75, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x4a, 0x0a, 0x12, 0x52, 0x6f, 0x74,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4b, 0x65, 0x79,
-	0x12, 0x1d, 0x2e, 0x64, 0x75, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x6f,
-	0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4b, 0x65,
-	0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x4a,
-	0x0a, 0x10, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x4b, 0x65, 0x79, 0x12, 0x1b, 0x2e, 0x64, 0x75, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4b,
-	0x65, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x03, 0x88, 0x02, 0x01, 0x42,
-	0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68,
-	0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x44, 0x75,
-	0x74, 0x79, 0x2f, 0x78, 0x2f, 0x64, 0x75, 0x74, 0x79, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// CheckpointSignature is a single validator signature over a checkpoint
+type CheckpointSignature struct {
+	ValConsAddr string `protobuf:"bytes,1,opt,name=val_cons_addr,json=valConsAddr,proto3" json:"val_cons_addr,omitempty"`
+	Signature   string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	VotingPower string `protobuf:"bytes,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
 }
 
-var (
-	file_proto_duty_v1_tx_proto_rawDescOnce sync.Once
-	file_proto_duty_v1_tx_proto_rawDescData = file_proto_duty_v1_tx_proto_rawDesc
-)
+func (m *CheckpointSignature) Reset()         { *m = CheckpointSignature{} }
+func (m *CheckpointSignature) String() string { return proto.CompactTextString(m) }
+func (*CheckpointSignature) ProtoMessage()    {}
+func (*CheckpointSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{8}
+}
+func (m *CheckpointSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointSignature.Merge(m, src)
+}
+func (m *CheckpointSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointSignature.DiscardUnknown(m)
+}
 
-func file_proto_duty_v1_tx_proto_rawDescGZIP() []byte {
-	file_proto_duty_v1_tx_proto_rawDescOnce.Do(func() {
-		file_proto_duty_v1_tx_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_duty_v1_tx_proto_rawDescData)
-	})
-	return file_proto_duty_v1_tx_proto_rawDescData
-}
-
-var file_proto_duty_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_proto_duty_v1_tx_proto_goTypes = []interface{}{
-	(*MsgSetDutyMetadata)(nil),    // 0: duty.v1.MsgSetDutyMetadata
-	(*MsgRotateCheckpointKey)(nil), // 1: duty.v1.MsgRotateCheckpointKey
-	(*MsgBindCheckpointKey)(nil),   // 2: duty.v1.MsgBindCheckpointKey
-	(*DutyMetadata)(nil),           // 3: duty.v1.DutyMetadata
-	(*emptypb.Empty)(nil),          // 4: google.protobuf.Empty
-}
-var file_proto_duty_v1_tx_proto_depIdxs = []int32{
-	3, // 0: duty.v1.MsgSetDutyMetadata.metadata:type_name -> duty.v1.DutyMetadata
-	0, // 1: duty.v1.Msg.SetDutyMetadata:input_type -> duty.v1.MsgSetDutyMetadata
-	1, // 2: duty.v1.Msg.RotateCheckpointKey:input_type -> duty.v1.MsgRotateCheckpointKey
-	2, // 3: duty.v1.Msg.BindCheckpointKey:input_type -> duty.v1.MsgBindCheckpointKey
-	4, // 4: duty.v1.Msg.SetDutyMetadata:output_type -> google.protobuf.Empty
-	4, // 5: duty.v1.Msg.RotateCheckpointKey:output_type -> google.protobuf.Empty
-	4, // 6: duty.v1.Msg.BindCheckpointKey:output_type -> google.protobuf.Empty
-}
-
-func init() { file_proto_duty_v1_tx_proto_init() }
-func file_proto_duty_v1_tx_proto_init() {
-	if File_proto_duty_v1_tx_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_duty_v1_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetDutyMetadata); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_duty_v1_tx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRotateCheckpointKey); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_duty_v1_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgBindCheckpointKey); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_duty_v1_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DutyMetadata); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_proto_duty_v1_tx_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_proto_duty_v1_tx_proto_goTypes,
-		DependencyIndexes: file_proto_duty_v1_tx_proto_depIdxs,
-		MessageInfos:      file_proto_duty_v1_tx_proto_msgTypes,
-	}.Build()
-	File_proto_duty_v1_tx_proto = out.File
-	file_proto_duty_v1_tx_proto_rawDesc = nil
-	file_proto_duty_v1_tx_proto_goTypes = nil
-	file_proto_duty_v1_tx_proto_depIdxs = nil
+var xxx_messageInfo_CheckpointSignature proto.InternalMessageInfo
+
+func (m *CheckpointSignature) GetValConsAddr() string {
+	if m != nil {
+		return m.ValConsAddr
+	}
+	return ""
 }
+
+func (m *CheckpointSignature) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *CheckpointSignature) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
+	}
+	return ""
+}
+
+// Checkpoint aggregates the signatures collected for a Hyperlane checkpoint
+// against the active duty set of the epoch it was first signed in
+type Checkpoint struct {
+	OriginDomain   uint32 `protobuf:"varint,1,opt,name=origin_domain,json=originDomain,proto3" json:"origin_domain,omitempty"`
+	MerkleTreeHook string `protobuf:"bytes,2,opt,name=merkle_tree_hook,json=merkleTreeHook,proto3" json:"merkle_tree_hook,omitempty"`
+	Root           string `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Index          uint32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	MessageId      string `protobuf:"bytes,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// digest is the Hyperlane checkpoint digest signed by validators
+	Digest        string                `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
+	Epoch         uint64                `protobuf:"varint,7,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Signatures    []CheckpointSignature `protobuf:"bytes,8,rep,name=signatures,proto3" json:"signatures"`
+	SignedPower   string                `protobuf:"bytes,9,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+	TotalPower    string                `protobuf:"bytes,10,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	QuorumReached bool                  `protobuf:"varint,11,opt,name=quorum_reached,json=quorumReached,proto3" json:"quorum_reached,omitempty"`
+	QuorumHeight  int64                 `protobuf:"varint,12,opt,name=quorum_height,json=quorumHeight,proto3" json:"quorum_height,omitempty"`
+	// liveness_deadline is the height at whose EndBlock the signers of the
+	// checkpoint are recorded for liveness, quorum_height plus the
+	// checkpoint_signing_grace_period param. Set when quorum is reached.
+	LivenessDeadline int64 `protobuf:"varint,13,opt,name=liveness_deadline,json=livenessDeadline,proto3" json:"liveness_deadline,omitempty"`
+}
+
+func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
+func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
+func (*Checkpoint) ProtoMessage()    {}
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{9}
+}
+func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkpoint.Merge(m, src)
+}
+func (m *Checkpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+
+func (m *Checkpoint) GetOriginDomain() uint32 {
+	if m != nil {
+		return m.OriginDomain
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetMerkleTreeHook() string {
+	if m != nil {
+		return m.MerkleTreeHook
+	}
+	return ""
+}
+
+func (m *Checkpoint) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *Checkpoint) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *Checkpoint) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *Checkpoint) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetSignatures() []CheckpointSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *Checkpoint) GetSignedPower() string {
+	if m != nil {
+		return m.SignedPower
+	}
+	return ""
+}
+
+func (m *Checkpoint) GetTotalPower() string {
+	if m != nil {
+		return m.TotalPower
+	}
+	return ""
+}
+
+func (m *Checkpoint) GetQuorumReached() bool {
+	if m != nil {
+		return m.QuorumReached
+	}
+	return false
+}
+
+func (m *Checkpoint) GetQuorumHeight() int64 {
+	if m != nil {
+		return m.QuorumHeight
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetLivenessDeadline() int64 {
+	if m != nil {
+		return m.LivenessDeadline
+	}
+	return 0
+}
+
+// CheckpointSigningInfo tracks a validator's checkpoint signing liveness over
+// a sliding window of quorum checkpoints, like x/slashing's ValidatorSigningInfo
+type CheckpointSigningInfo struct {
+	ValConsAddr string `protobuf:"bytes,1,opt,name=val_cons_addr,json=valConsAddr,proto3" json:"val_cons_addr,omitempty"`
+	// start_height is the height at which tracking started
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// index_offset is the number of checkpoints tracked since start_height
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_checkpoints_counter is the number of missed checkpoints in the window
+	MissedCheckpointsCounter int64 `protobuf:"varint,4,opt,name=missed_checkpoints_counter,json=missedCheckpointsCounter,proto3" json:"missed_checkpoints_counter,omitempty"`
+}
+
+func (m *CheckpointSigningInfo) Reset()         { *m = CheckpointSigningInfo{} }
+func (m *CheckpointSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*CheckpointSigningInfo) ProtoMessage()    {}
+func (*CheckpointSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{10}
+}
+func (m *CheckpointSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointSigningInfo.Merge(m, src)
+}
+func (m *CheckpointSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointSigningInfo proto.InternalMessageInfo
+
+func (m *CheckpointSigningInfo) GetValConsAddr() string {
+	if m != nil {
+		return m.ValConsAddr
+	}
+	return ""
+}
+
+func (m *CheckpointSigningInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *CheckpointSigningInfo) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *CheckpointSigningInfo) GetMissedCheckpointsCounter() int64 {
+	if m != nil {
+		return m.MissedCheckpointsCounter
+	}
+	return 0
+}
+
+// SignedCheckpoint is a Hyperlane checkpoint together with a validator signature over it
+type SignedCheckpoint struct {
+	OriginDomain   uint32 `protobuf:"varint,1,opt,name=origin_domain,json=originDomain,proto3" json:"origin_domain,omitempty"`
+	MerkleTreeHook string `protobuf:"bytes,2,opt,name=merkle_tree_hook,json=merkleTreeHook,proto3" json:"merkle_tree_hook,omitempty"`
+	Root           string `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Index          uint32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	MessageId      string `protobuf:"bytes,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// signature is the 65-byte [R || S || V] EIP-191 signature over the
+	// checkpoint digest, hex encoded
+	Signature string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignedCheckpoint) Reset()         { *m = SignedCheckpoint{} }
+func (m *SignedCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*SignedCheckpoint) ProtoMessage()    {}
+func (*SignedCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{11}
+}
+func (m *SignedCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedCheckpoint.Merge(m, src)
+}
+func (m *SignedCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedCheckpoint proto.InternalMessageInfo
+
+func (m *SignedCheckpoint) GetOriginDomain() uint32 {
+	if m != nil {
+		return m.OriginDomain
+	}
+	return 0
+}
+
+func (m *SignedCheckpoint) GetMerkleTreeHook() string {
+	if m != nil {
+		return m.MerkleTreeHook
+	}
+	return ""
+}
+
+func (m *SignedCheckpoint) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *SignedCheckpoint) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *SignedCheckpoint) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *SignedCheckpoint) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+// MsgSubmitCheckpointEquivocation defines the SubmitCheckpointEquivocation message
+type MsgSubmitCheckpointEquivocation struct {
+	// submitter is the account submitting the evidence
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// first and second must be signed by the same checkpoint key for the same
+	// origin domain, merkle tree hook and index, but commit to different
+	// roots or message IDs
+	First  SignedCheckpoint `protobuf:"bytes,2,opt,name=first,proto3" json:"first"`
+	Second SignedCheckpoint `protobuf:"bytes,3,opt,name=second,proto3" json:"second"`
+}
+
+func (m *MsgSubmitCheckpointEquivocation) Reset()         { *m = MsgSubmitCheckpointEquivocation{} }
+func (m *MsgSubmitCheckpointEquivocation) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitCheckpointEquivocation) ProtoMessage()    {}
+func (*MsgSubmitCheckpointEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{12}
+}
+func (m *MsgSubmitCheckpointEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitCheckpointEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitCheckpointEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitCheckpointEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitCheckpointEquivocation.Merge(m, src)
+}
+func (m *MsgSubmitCheckpointEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitCheckpointEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitCheckpointEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitCheckpointEquivocation proto.InternalMessageInfo
+
+func (m *MsgSubmitCheckpointEquivocation) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgSubmitCheckpointEquivocation) GetFirst() SignedCheckpoint {
+	if m != nil {
+		return m.First
+	}
+	return SignedCheckpoint{}
+}
+
+func (m *MsgSubmitCheckpointEquivocation) GetSecond() SignedCheckpoint {
+	if m != nil {
+		return m.Second
+	}
+	return SignedCheckpoint{}
+}
+
+// MsgUpdateParams defines the UpdateParams message
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to the gov
+	// module account)
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params replaces all module parameters
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{13}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response to MsgUpdateParams
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{14}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgRegisterOriginDomain defines the RegisterOriginDomain message
+type MsgRegisterOriginDomain struct {
+	// authority is the address that controls the module (defaults to the gov
+	// module account)
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// domain is stored under its domain_id, replacing any existing entry
+	Domain OriginDomain `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+}
+
+func (m *MsgRegisterOriginDomain) Reset()         { *m = MsgRegisterOriginDomain{} }
+func (m *MsgRegisterOriginDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterOriginDomain) ProtoMessage()    {}
+func (*MsgRegisterOriginDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{15}
+}
+func (m *MsgRegisterOriginDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterOriginDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterOriginDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterOriginDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterOriginDomain.Merge(m, src)
+}
+func (m *MsgRegisterOriginDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterOriginDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterOriginDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterOriginDomain proto.InternalMessageInfo
+
+func (m *MsgRegisterOriginDomain) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRegisterOriginDomain) GetDomain() OriginDomain {
+	if m != nil {
+		return m.Domain
+	}
+	return OriginDomain{}
+}
+
+// MsgRegisterOriginDomainResponse defines the response to MsgRegisterOriginDomain
+type MsgRegisterOriginDomainResponse struct {
+}
+
+func (m *MsgRegisterOriginDomainResponse) Reset()         { *m = MsgRegisterOriginDomainResponse{} }
+func (m *MsgRegisterOriginDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterOriginDomainResponse) ProtoMessage()    {}
+func (*MsgRegisterOriginDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{16}
+}
+func (m *MsgRegisterOriginDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterOriginDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterOriginDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterOriginDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterOriginDomainResponse.Merge(m, src)
+}
+func (m *MsgRegisterOriginDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterOriginDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterOriginDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterOriginDomainResponse proto.InternalMessageInfo
+
+// MsgRemoveOriginDomain defines the RemoveOriginDomain message
+type MsgRemoveOriginDomain struct {
+	// authority is the address that controls the module (defaults to the gov
+	// module account)
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	DomainId  uint32 `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+}
+
+func (m *MsgRemoveOriginDomain) Reset()         { *m = MsgRemoveOriginDomain{} }
+func (m *MsgRemoveOriginDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOriginDomain) ProtoMessage()    {}
+func (*MsgRemoveOriginDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{17}
+}
+func (m *MsgRemoveOriginDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOriginDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOriginDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOriginDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOriginDomain.Merge(m, src)
+}
+func (m *MsgRemoveOriginDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOriginDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOriginDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOriginDomain proto.InternalMessageInfo
+
+func (m *MsgRemoveOriginDomain) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveOriginDomain) GetDomainId() uint32 {
+	if m != nil {
+		return m.DomainId
+	}
+	return 0
+}
+
+// MsgRemoveOriginDomainResponse defines the response to MsgRemoveOriginDomain
+type MsgRemoveOriginDomainResponse struct {
+}
+
+func (m *MsgRemoveOriginDomainResponse) Reset()         { *m = MsgRemoveOriginDomainResponse{} }
+func (m *MsgRemoveOriginDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOriginDomainResponse) ProtoMessage()    {}
+func (*MsgRemoveOriginDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61c9dc41081cfbb, []int{18}
+}
+func (m *MsgRemoveOriginDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOriginDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOriginDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOriginDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOriginDomainResponse.Merge(m, src)
+}
+func (m *MsgRemoveOriginDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOriginDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOriginDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOriginDomainResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetDutyMetadata)(nil), "duty.v1.MsgSetDutyMetadata")
+	proto.RegisterType((*MsgRotateCheckpointKey)(nil), "duty.v1.MsgRotateCheckpointKey")
+	proto.RegisterType((*MsgBindCheckpointKey)(nil), "duty.v1.MsgBindCheckpointKey")
+	proto.RegisterType((*DutyMetadata)(nil), "duty.v1.DutyMetadata")
+	proto.RegisterType((*CheckpointKeyRecord)(nil), "duty.v1.CheckpointKeyRecord")
+	proto.RegisterType((*DutyMetadataTombstone)(nil), "duty.v1.DutyMetadataTombstone")
+	proto.RegisterType((*ConsensusKeyMigration)(nil), "duty.v1.ConsensusKeyMigration")
+	proto.RegisterType((*MsgSubmitCheckpointSignature)(nil), "duty.v1.MsgSubmitCheckpointSignature")
+	proto.RegisterType((*CheckpointSignature)(nil), "duty.v1.CheckpointSignature")
+	proto.RegisterType((*Checkpoint)(nil), "duty.v1.Checkpoint")
+	proto.RegisterType((*CheckpointSigningInfo)(nil), "duty.v1.CheckpointSigningInfo")
+	proto.RegisterType((*SignedCheckpoint)(nil), "duty.v1.SignedCheckpoint")
+	proto.RegisterType((*MsgSubmitCheckpointEquivocation)(nil), "duty.v1.MsgSubmitCheckpointEquivocation")
+	proto.RegisterType((*MsgUpdateParams)(nil), "duty.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "duty.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterOriginDomain)(nil), "duty.v1.MsgRegisterOriginDomain")
+	proto.RegisterType((*MsgRegisterOriginDomainResponse)(nil), "duty.v1.MsgRegisterOriginDomainResponse")
+	proto.RegisterType((*MsgRemoveOriginDomain)(nil), "duty.v1.MsgRemoveOriginDomain")
+	proto.RegisterType((*MsgRemoveOriginDomainResponse)(nil), "duty.v1.MsgRemoveOriginDomainResponse")
+}
+
+func init() { proto.RegisterFile("duty/v1/tx.proto", fileDescriptor_c61c9dc41081cfbb) }
+
+var fileDescriptor_c61c9dc41081cfbb = []byte{
+	// 1508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x2d, 0x59, 0xb6, 0x47, 0x92, 0x3f, 0xd6, 0x1f, 0xaf, 0xec, 0x38, 0xb2, 0xc2, 0x17,
+	0x79, 0x21, 0xbc, 0x49, 0x25, 0x24, 0x6e, 0x11, 0x14, 0x68, 0x51, 0xc4, 0x76, 0x8c, 0xa4, 0x86,
+	0x9b, 0x80, 0x4e, 0x7a, 0x08, 0x8a, 0xb2, 0x14, 0xb9, 0xa6, 0x16, 0x16, 0xb9, 0x0a, 0x77, 0x29,
+	0x47, 0xfd, 0x15, 0xfd, 0x0d, 0xfd, 0x03, 0xed, 0xa5, 0x05, 0x7a, 0x2a, 0x7a, 0x0b, 0x7a, 0x0a,
+	0xd0, 0x4b, 0x4f, 0x45, 0x91, 0x5c, 0xfb, 0x23, 0x8a, 0xfd, 0xa0, 0x48, 0x7d, 0xb9, 0xce, 0xb1,
+	0x37, 0xed, 0xcc, 0xb3, 0xcf, 0xce, 0x3c, 0x9c, 0x19, 0x72, 0x05, 0x2b, 0x5e, 0xcc, 0xfb, 0xcd,
+	0xde, 0x9d, 0x26, 0x7f, 0xd9, 0xe8, 0x46, 0x94, 0x53, 0x34, 0x2f, 0x2c, 0x8d, 0xde, 0x9d, 0xed,
+	0x75, 0x9f, 0xfa, 0x54, 0xda, 0x9a, 0xe2, 0x97, 0x72, 0x6f, 0x5f, 0xf3, 0x29, 0xf5, 0x3b, 0xb8,
+	0x29, 0x57, 0xad, 0xf8, 0xac, 0x89, 0x83, 0x2e, 0xef, 0x6b, 0xe7, 0x7a, 0xc2, 0xd6, 0x75, 0x22,
+	0x27, 0x60, 0xa3, 0x56, 0x8f, 0x06, 0x0e, 0x09, 0x95, 0xd5, 0xfc, 0xc5, 0x00, 0x74, 0xc2, 0xfc,
+	0x53, 0xcc, 0x0f, 0x63, 0xde, 0x3f, 0xc1, 0xdc, 0xf1, 0x1c, 0xee, 0xa0, 0x4d, 0x28, 0x30, 0xe2,
+	0x87, 0x38, 0xaa, 0x18, 0x35, 0xa3, 0xbe, 0x68, 0xe9, 0x15, 0xba, 0x07, 0x0b, 0x81, 0xc6, 0x54,
+	0x66, 0x6b, 0x46, 0xbd, 0x78, 0x77, 0xa3, 0xa1, 0x23, 0x6d, 0x64, 0x09, 0xf6, 0xf3, 0xaf, 0xfe,
+	0xd8, 0x9d, 0xb1, 0x06, 0x60, 0x74, 0x08, 0x65, 0x27, 0x0c, 0x69, 0x1c, 0xba, 0x38, 0xc0, 0x21,
+	0x67, 0x95, 0x5c, 0x2d, 0x57, 0x2f, 0xde, 0xad, 0x0e, 0x76, 0x7f, 0xee, 0x74, 0x88, 0xe7, 0x70,
+	0x1a, 0xdd, 0xcf, 0xc0, 0xac, 0xe1, 0x4d, 0x68, 0x1d, 0xe6, 0x42, 0x1a, 0xba, 0xb8, 0x92, 0xaf,
+	0x19, 0xf5, 0xbc, 0xa5, 0x16, 0xe6, 0xf7, 0x06, 0x6c, 0x9e, 0x30, 0xdf, 0xa2, 0xdc, 0xe1, 0xf8,
+	0xa0, 0x8d, 0xdd, 0xf3, 0x2e, 0x25, 0x21, 0x3f, 0xc6, 0xfd, 0xa9, 0x79, 0xec, 0xc1, 0x66, 0x88,
+	0x2f, 0x6c, 0x77, 0x00, 0xb6, 0xbb, 0x71, 0xcb, 0x3e, 0xc7, 0x7d, 0x99, 0xd5, 0xa2, 0xb5, 0x16,
+	0xe2, 0x8b, 0x94, 0xe9, 0x49, 0xdc, 0x12, 0x64, 0x7b, 0xb0, 0xe1, 0x70, 0x8e, 0x19, 0x77, 0x38,
+	0xa1, 0xa1, 0x2d, 0xa8, 0x1c, 0x1e, 0x47, 0xb8, 0x92, 0x93, 0x7b, 0xd6, 0x33, 0xce, 0xd3, 0xc4,
+	0x37, 0x25, 0xe4, 0x6f, 0x67, 0x61, 0xfd, 0x84, 0xf9, 0xfb, 0x24, 0xf4, 0xae, 0x16, 0xf0, 0x6d,
+	0x40, 0x53, 0x83, 0x5d, 0x71, 0x47, 0x23, 0xbd, 0x05, 0xab, 0x2d, 0x12, 0x7a, 0x24, 0xf4, 0xc7,
+	0xa2, 0x5c, 0xd1, 0x8e, 0x34, 0xc2, 0x5b, 0xb0, 0xea, 0xd2, 0x90, 0xe1, 0x90, 0xc5, 0xcc, 0x76,
+	0x3c, 0x2f, 0xc2, 0x8c, 0x55, 0xf2, 0x9a, 0x39, 0x71, 0xdc, 0x57, 0x76, 0xd4, 0x84, 0xb5, 0x14,
+	0x9c, 0x72, 0xcf, 0x49, 0x38, 0x1a, 0xb8, 0x26, 0xe4, 0x5f, 0xc8, 0xe4, 0x8f, 0xfe, 0x0b, 0x65,
+	0x1a, 0x11, 0x9f, 0x84, 0xb6, 0xaa, 0xc6, 0xca, 0x7c, 0xcd, 0xa8, 0x97, 0xad, 0x92, 0x32, 0x1e,
+	0x4a, 0x9b, 0xf9, 0x53, 0x0e, 0x4a, 0x43, 0x55, 0x39, 0x59, 0x04, 0x63, 0x8a, 0x08, 0xef, 0xc3,
+	0x66, 0x06, 0xcd, 0x38, 0x8d, 0x1c, 0x1f, 0xdb, 0x71, 0x44, 0xb4, 0x6c, 0xeb, 0xa9, 0xf7, 0x54,
+	0x39, 0x9f, 0x45, 0x04, 0x1d, 0xc1, 0x92, 0x0a, 0xc9, 0x76, 0x69, 0x78, 0x46, 0xfc, 0xa4, 0x52,
+	0x77, 0xd3, 0x3a, 0x97, 0xee, 0xf4, 0xb1, 0x1d, 0x48, 0x9c, 0x55, 0x56, 0xdb, 0xd4, 0x8a, 0xa1,
+	0xfd, 0xd1, 0x82, 0xcf, 0x4b, 0x9a, 0x9d, 0x01, 0x8d, 0x3e, 0xf3, 0xb2, 0x72, 0xff, 0x10, 0xb6,
+	0x32, 0x19, 0x9c, 0xe3, 0xbe, 0xdd, 0x13, 0x5d, 0x62, 0x9f, 0x45, 0x34, 0x90, 0x92, 0xe7, 0xac,
+	0x4c, 0x8a, 0xc7, 0xb8, 0x2f, 0x9b, 0xe8, 0x28, 0xa2, 0x01, 0xfa, 0x18, 0x8a, 0x5d, 0xac, 0x2a,
+	0x40, 0x68, 0x54, 0xa8, 0x19, 0x43, 0x87, 0x0f, 0x15, 0x9d, 0x85, 0x5d, 0x1a, 0x79, 0x16, 0xe8,
+	0x0d, 0x42, 0xbb, 0x4f, 0xa0, 0xd4, 0x8d, 0x70, 0x8f, 0xd0, 0x98, 0xc9, 0xfd, 0xf3, 0x57, 0xd8,
+	0x5f, 0x4c, 0x76, 0x1c, 0xe3, 0xbe, 0xf9, 0xab, 0x01, 0x6b, 0x13, 0x40, 0xef, 0xf8, 0x08, 0xff,
+	0x0f, 0xab, 0x69, 0xc6, 0x76, 0x1b, 0x13, 0xbf, 0xcd, 0xe5, 0xd3, 0xcb, 0x59, 0xcb, 0xbd, 0x24,
+	0xd7, 0x87, 0xd2, 0x2c, 0x98, 0x15, 0x36, 0x0e, 0x39, 0xe9, 0x24, 0xe0, 0x9c, 0x04, 0xaf, 0x48,
+	0xcf, 0x33, 0xe1, 0xd0, 0xe8, 0x5b, 0xb0, 0xea, 0xb8, 0x9c, 0xf4, 0x54, 0x2b, 0x6b, 0x70, 0x5e,
+	0x81, 0x53, 0x87, 0x02, 0x9b, 0x3f, 0x1a, 0xb0, 0x91, 0x2d, 0xc4, 0xa7, 0x34, 0x68, 0x31, 0x4e,
+	0x43, 0x8c, 0x4c, 0x28, 0xf7, 0x9c, 0x8e, 0x28, 0x15, 0xd5, 0x3a, 0x3a, 0x93, 0x62, 0xcf, 0xe9,
+	0x1c, 0xd0, 0x50, 0x76, 0x0d, 0xda, 0x82, 0x05, 0x81, 0x91, 0x6e, 0x55, 0x79, 0xf3, 0x3d, 0xa7,
+	0x23, 0x5d, 0xd9, 0x71, 0x9a, 0x7b, 0x97, 0x71, 0x7a, 0x13, 0x96, 0x22, 0x1c, 0x50, 0xc1, 0x3b,
+	0x14, 0x7b, 0x59, 0x5b, 0x75, 0xe0, 0x17, 0xb0, 0x71, 0x90, 0xb4, 0xe4, 0x31, 0xee, 0x9f, 0x10,
+	0x3f, 0x92, 0x79, 0x89, 0xb8, 0x69, 0xc7, 0x1b, 0x8f, 0x9b, 0x76, 0xbc, 0x41, 0xdc, 0x26, 0x94,
+	0xe5, 0x8c, 0x1c, 0x60, 0x54, 0xf0, 0x45, 0x31, 0x1a, 0x13, 0xcc, 0x26, 0x14, 0x86, 0x84, 0xd6,
+	0x2b, 0xf3, 0x2f, 0x03, 0x76, 0xc4, 0x6b, 0x25, 0x6e, 0x05, 0x84, 0xa7, 0x75, 0x90, 0x8e, 0x85,
+	0x69, 0x73, 0x6e, 0x6c, 0x30, 0xcc, 0x8e, 0x0f, 0x06, 0x54, 0x87, 0x95, 0x00, 0x47, 0xe7, 0x1d,
+	0x6c, 0xf3, 0x08, 0x63, 0xbb, 0x4d, 0xe9, 0xb9, 0x9e, 0x6e, 0x4b, 0xca, 0xfe, 0x34, 0xc2, 0xf8,
+	0x21, 0xa5, 0xe7, 0x08, 0x41, 0x3e, 0xa2, 0x94, 0xeb, 0x71, 0x26, 0x7f, 0x8b, 0x89, 0x44, 0x42,
+	0x0f, 0xbf, 0x94, 0x1d, 0x54, 0xb6, 0xd4, 0x02, 0x5d, 0x07, 0x08, 0x30, 0x63, 0x62, 0x44, 0x10,
+	0x4f, 0xf6, 0xcb, 0xa2, 0xb5, 0xa8, 0x2d, 0x8f, 0x3c, 0xb4, 0x03, 0x8b, 0xe9, 0xb4, 0x9b, 0x57,
+	0xde, 0x81, 0xc1, 0xfc, 0x1a, 0xd6, 0x26, 0x25, 0x79, 0x95, 0xea, 0x18, 0x22, 0x9e, 0x1d, 0x21,
+	0x46, 0x37, 0xa0, 0xd4, 0xa3, 0x5c, 0x74, 0x71, 0x97, 0x5e, 0xe0, 0x48, 0x67, 0x59, 0x54, 0xb6,
+	0x27, 0xc2, 0x64, 0xfe, 0x96, 0x03, 0x48, 0x0f, 0x1f, 0x17, 0xd0, 0xb8, 0xa2, 0x80, 0xb3, 0x97,
+	0x0a, 0x98, 0x9b, 0x24, 0x60, 0x7e, 0xba, 0x80, 0x73, 0xa3, 0x02, 0x6e, 0x42, 0xc1, 0x23, 0x3e,
+	0x66, 0x5c, 0x6b, 0xab, 0x57, 0x82, 0x0c, 0x77, 0xa9, 0xdb, 0x96, 0xa2, 0xe6, 0x2d, 0xb5, 0x40,
+	0xfb, 0x00, 0x03, 0x11, 0x58, 0x65, 0x61, 0x64, 0x74, 0x4e, 0xd0, 0x5a, 0x77, 0x48, 0x66, 0x97,
+	0xd0, 0x4e, 0xac, 0xb0, 0xa7, 0xb5, 0x5b, 0x54, 0xda, 0x29, 0x9b, 0xd4, 0x0e, 0xed, 0x42, 0x91,
+	0x53, 0xee, 0x74, 0x34, 0x02, 0x24, 0x02, 0xa4, 0x49, 0x01, 0x6e, 0xc2, 0xd2, 0x8b, 0x98, 0x46,
+	0x71, 0x60, 0x47, 0xd8, 0x71, 0xdb, 0xd8, 0xab, 0x14, 0x6b, 0x46, 0x7d, 0xc1, 0x2a, 0x2b, 0xab,
+	0xa5, 0x8c, 0x42, 0x74, 0x0d, 0xd3, 0xdd, 0x50, 0x92, 0xdd, 0x50, 0x52, 0xc6, 0x74, 0xe4, 0x74,
+	0x48, 0x0f, 0x87, 0x98, 0x31, 0xdb, 0xc3, 0x8e, 0xd7, 0x21, 0x21, 0xae, 0x94, 0xd5, 0xc8, 0x49,
+	0x1c, 0x87, 0xda, 0x6e, 0xfe, 0x6c, 0xc0, 0xc6, 0x70, 0x9a, 0x24, 0xf4, 0x1f, 0x85, 0x67, 0xf4,
+	0x4a, 0x45, 0x25, 0x52, 0xe7, 0x4e, 0xc4, 0x87, 0x47, 0x66, 0x51, 0xda, 0x74, 0x34, 0x37, 0xa0,
+	0x24, 0x9f, 0x9b, 0x4d, 0xcf, 0xce, 0x18, 0x4e, 0xfa, 0xb7, 0x28, 0x6d, 0x8f, 0xa5, 0x09, 0x7d,
+	0x04, 0xdb, 0x01, 0x61, 0x0c, 0x7b, 0x99, 0xef, 0x24, 0x66, 0xbb, 0x34, 0x0e, 0x39, 0x8e, 0xf4,
+	0xc0, 0xa9, 0x28, 0x44, 0x1a, 0x2a, 0x3b, 0x50, 0x7e, 0xf3, 0x95, 0x01, 0x2b, 0xa7, 0x52, 0xeb,
+	0x7f, 0x41, 0x75, 0x0e, 0x75, 0x61, 0x61, 0xb4, 0xbd, 0xbf, 0x33, 0x60, 0x77, 0xc2, 0x34, 0x7b,
+	0xf0, 0x22, 0x26, 0x3d, 0xea, 0xaa, 0x89, 0x2a, 0x18, 0xa4, 0x9f, 0x0f, 0x66, 0x5a, 0x6a, 0x40,
+	0x1f, 0xc0, 0xdc, 0x19, 0x89, 0x18, 0xd7, 0x1f, 0xcd, 0x5b, 0xe9, 0x57, 0xc0, 0x88, 0x42, 0xba,
+	0x8e, 0x15, 0x1a, 0xdd, 0x83, 0x02, 0xc3, 0x2e, 0x0d, 0xbd, 0x4a, 0xee, 0x6a, 0xfb, 0x34, 0xdc,
+	0xfc, 0x12, 0x96, 0x4f, 0x98, 0xff, 0xac, 0xeb, 0x39, 0x1c, 0x3f, 0x91, 0xb7, 0x00, 0x11, 0xa0,
+	0x13, 0xf3, 0x36, 0x8d, 0x08, 0x4f, 0x5e, 0xb8, 0xa9, 0x01, 0xbd, 0x07, 0x05, 0x75, 0x5b, 0xd0,
+	0x11, 0x2e, 0x0f, 0x4e, 0x52, 0xdb, 0x13, 0x7e, 0x05, 0x32, 0xb7, 0xe0, 0x3f, 0x23, 0xfc, 0x16,
+	0x66, 0x5d, 0xf1, 0xaa, 0x31, 0x3b, 0xd2, 0x65, 0x61, 0x9f, 0x30, 0x8e, 0xa3, 0xc7, 0xd9, 0x07,
+	0x7b, 0x79, 0x08, 0x7b, 0x50, 0xc8, 0xcc, 0xfc, 0xec, 0xab, 0x30, 0x4b, 0x92, 0x04, 0xa2, 0xa0,
+	0xe6, 0x0d, 0xd8, 0x9d, 0x72, 0xda, 0x20, 0x20, 0x0b, 0x36, 0x24, 0x24, 0xa0, 0x3d, 0xfc, 0x0e,
+	0xe1, 0x5c, 0x83, 0x45, 0xfd, 0x21, 0x48, 0x3c, 0xfd, 0x16, 0x5a, 0x50, 0x86, 0x47, 0x9e, 0xb9,
+	0x0b, 0xd7, 0x27, 0x72, 0x26, 0x87, 0xde, 0xfd, 0x61, 0x0e, 0x72, 0x27, 0xcc, 0x47, 0x47, 0xb0,
+	0x3c, 0x7a, 0xb7, 0xba, 0x36, 0xc8, 0x6b, 0xfc, 0xe2, 0xb5, 0xbd, 0xd9, 0x50, 0x37, 0xbb, 0x46,
+	0x72, 0xb3, 0x6b, 0x3c, 0x10, 0x37, 0x3b, 0xf4, 0x19, 0xac, 0x4d, 0xba, 0xdf, 0xec, 0x66, 0xb9,
+	0x26, 0x00, 0xa6, 0xf2, 0x7d, 0x0a, 0xab, 0xe3, 0x97, 0x8f, 0xeb, 0x59, 0xb6, 0x31, 0xf7, 0x54,
+	0xae, 0xe7, 0xb0, 0x35, 0xfd, 0x45, 0x7f, 0x73, 0x28, 0xdb, 0x69, 0xb0, 0xa9, 0xdc, 0x5f, 0xc1,
+	0xce, 0xa5, 0x6d, 0x57, 0xbf, 0x8c, 0x3e, 0x8b, 0xbc, 0x44, 0x89, 0xd2, 0x50, 0x9f, 0x54, 0xb2,
+	0x8c, 0x59, 0xcf, 0x76, 0x6d, 0x9a, 0x27, 0x79, 0xea, 0xa8, 0x05, 0xeb, 0x13, 0x0b, 0x7f, 0x68,
+	0xe7, 0x24, 0xc4, 0x76, 0xfd, 0x9f, 0x10, 0x83, 0x33, 0xbe, 0x00, 0x34, 0xa1, 0x96, 0xab, 0xc3,
+	0xfb, 0x47, 0xfd, 0xdb, 0xff, 0xbb, 0xdc, 0x9f, 0xb0, 0xef, 0x1f, 0xbd, 0x7a, 0x53, 0x35, 0x5e,
+	0xbf, 0xa9, 0x1a, 0x7f, 0xbe, 0xa9, 0x1a, 0xdf, 0xbc, 0xad, 0xce, 0xbc, 0x7e, 0x5b, 0x9d, 0xf9,
+	0xfd, 0x6d, 0x75, 0xe6, 0xf9, 0x6d, 0x9f, 0xf0, 0x76, 0xdc, 0x6a, 0xb8, 0x34, 0x68, 0x3e, 0x6d,
+	0xe3, 0xfb, 0x11, 0x27, 0x6e, 0xdc, 0x91, 0xda, 0x36, 0x45, 0x09, 0x37, 0x5f, 0x36, 0xe5, 0x3f,
+	0x0c, 0xbc, 0xdf, 0xc5, 0xac, 0x55, 0x90, 0x2a, 0xef, 0xfd, 0x3d, 0x00, 0x8b, 0x73, 0x0e, 0x28,
+	0xda, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetDutyMetadata sets the duty metadata for a validator
+	SetDutyMetadata(ctx context.Context, in *MsgSetDutyMetadata, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RotateCheckpointKey rotates the checkpoint signing key for a validator
+	RotateCheckpointKey(ctx context.Context, in *MsgRotateCheckpointKey, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// BindCheckpointKey creates a canonical binding between consensus validator and checkpoint key
+	BindCheckpointKey(ctx context.Context, in *MsgBindCheckpointKey, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SubmitCheckpointSignature records a validator's signature over a Hyperlane checkpoint
+	SubmitCheckpointSignature(ctx context.Context, in *MsgSubmitCheckpointSignature, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SubmitCheckpointEquivocation submits evidence of a checkpoint key signing
+	// two conflicting checkpoints for the same origin domain and index
+	SubmitCheckpointEquivocation(ctx context.Context, in *MsgSubmitCheckpointEquivocation, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UpdateParams replaces the module parameters. It can only be executed by
+	// the module authority, normally the gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterOriginDomain adds or replaces an origin domain in the registry.
+	// It can only be executed by the module authority.
+	RegisterOriginDomain(ctx context.Context, in *MsgRegisterOriginDomain, opts ...grpc.CallOption) (*MsgRegisterOriginDomainResponse, error)
+	// RemoveOriginDomain removes an origin domain from the registry. It can
+	// only be executed by the module authority.
+	RemoveOriginDomain(ctx context.Context, in *MsgRemoveOriginDomain, opts ...grpc.CallOption) (*MsgRemoveOriginDomainResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetDutyMetadata(ctx context.Context, in *MsgSetDutyMetadata, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/duty.v1.Msg/SetDutyMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateCheckpointKey(ctx context.Context, in *MsgRotateCheckpointKey, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/duty.v1.Msg/RotateCheckpointKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BindCheckpointKey(ctx context.Context, in *MsgBindCheckpointKey, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/duty.v1.Msg/BindCheckpointKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitCheckpointSignature(ctx context.Context, in *MsgSubmitCheckpointSignature, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/duty.v1.Msg/SubmitCheckpointSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitCheckpointEquivocation(ctx context.Context, in *MsgSubmitCheckpointEquivocation, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/duty.v1.Msg/SubmitCheckpointEquivocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterOriginDomain(ctx context.Context, in *MsgRegisterOriginDomain, opts ...grpc.CallOption) (*MsgRegisterOriginDomainResponse, error) {
+	out := new(MsgRegisterOriginDomainResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Msg/RegisterOriginDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveOriginDomain(ctx context.Context, in *MsgRemoveOriginDomain, opts ...grpc.CallOption) (*MsgRemoveOriginDomainResponse, error) {
+	out := new(MsgRemoveOriginDomainResponse)
+	err := c.cc.Invoke(ctx, "/duty.v1.Msg/RemoveOriginDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetDutyMetadata sets the duty metadata for a validator
+	SetDutyMetadata(context.Context, *MsgSetDutyMetadata) (*emptypb.Empty, error)
+	// RotateCheckpointKey rotates the checkpoint signing key for a validator
+	RotateCheckpointKey(context.Context, *MsgRotateCheckpointKey) (*emptypb.Empty, error)
+	// BindCheckpointKey creates a canonical binding between consensus validator and checkpoint key
+	BindCheckpointKey(context.Context, *MsgBindCheckpointKey) (*emptypb.Empty, error)
+	// SubmitCheckpointSignature records a validator's signature over a Hyperlane checkpoint
+	SubmitCheckpointSignature(context.Context, *MsgSubmitCheckpointSignature) (*emptypb.Empty, error)
+	// SubmitCheckpointEquivocation submits evidence of a checkpoint key signing
+	// two conflicting checkpoints for the same origin domain and index
+	SubmitCheckpointEquivocation(context.Context, *MsgSubmitCheckpointEquivocation) (*emptypb.Empty, error)
+	// UpdateParams replaces the module parameters. It can only be executed by
+	// the module authority, normally the gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterOriginDomain adds or replaces an origin domain in the registry.
+	// It can only be executed by the module authority.
+	RegisterOriginDomain(context.Context, *MsgRegisterOriginDomain) (*MsgRegisterOriginDomainResponse, error)
+	// RemoveOriginDomain removes an origin domain from the registry. It can
+	// only be executed by the module authority.
+	RemoveOriginDomain(context.Context, *MsgRemoveOriginDomain) (*MsgRemoveOriginDomainResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetDutyMetadata(ctx context.Context, req *MsgSetDutyMetadata) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDutyMetadata not implemented")
+}
+func (*UnimplementedMsgServer) RotateCheckpointKey(ctx context.Context, req *MsgRotateCheckpointKey) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCheckpointKey not implemented")
+}
+func (*UnimplementedMsgServer) BindCheckpointKey(ctx context.Context, req *MsgBindCheckpointKey) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindCheckpointKey not implemented")
+}
+func (*UnimplementedMsgServer) SubmitCheckpointSignature(ctx context.Context, req *MsgSubmitCheckpointSignature) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCheckpointSignature not implemented")
+}
+func (*UnimplementedMsgServer) SubmitCheckpointEquivocation(ctx context.Context, req *MsgSubmitCheckpointEquivocation) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCheckpointEquivocation not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterOriginDomain(ctx context.Context, req *MsgRegisterOriginDomain) (*MsgRegisterOriginDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterOriginDomain not implemented")
+}
+func (*UnimplementedMsgServer) RemoveOriginDomain(ctx context.Context, req *MsgRemoveOriginDomain) (*MsgRemoveOriginDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOriginDomain not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetDutyMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDutyMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDutyMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Msg/SetDutyMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDutyMetadata(ctx, req.(*MsgSetDutyMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateCheckpointKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateCheckpointKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateCheckpointKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Msg/RotateCheckpointKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateCheckpointKey(ctx, req.(*MsgRotateCheckpointKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BindCheckpointKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindCheckpointKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BindCheckpointKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Msg/BindCheckpointKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindCheckpointKey(ctx, req.(*MsgBindCheckpointKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitCheckpointSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitCheckpointSignature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitCheckpointSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Msg/SubmitCheckpointSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitCheckpointSignature(ctx, req.(*MsgSubmitCheckpointSignature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitCheckpointEquivocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitCheckpointEquivocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitCheckpointEquivocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Msg/SubmitCheckpointEquivocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitCheckpointEquivocation(ctx, req.(*MsgSubmitCheckpointEquivocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterOriginDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterOriginDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterOriginDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Msg/RegisterOriginDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterOriginDomain(ctx, req.(*MsgRegisterOriginDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveOriginDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveOriginDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveOriginDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duty.v1.Msg/RemoveOriginDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveOriginDomain(ctx, req.(*MsgRemoveOriginDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "duty.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetDutyMetadata",
+			Handler:    _Msg_SetDutyMetadata_Handler,
+		},
+		{
+			MethodName: "RotateCheckpointKey",
+			Handler:    _Msg_RotateCheckpointKey_Handler,
+		},
+		{
+			MethodName: "BindCheckpointKey",
+			Handler:    _Msg_BindCheckpointKey_Handler,
+		},
+		{
+			MethodName: "SubmitCheckpointSignature",
+			Handler:    _Msg_SubmitCheckpointSignature_Handler,
+		},
+		{
+			MethodName: "SubmitCheckpointEquivocation",
+			Handler:    _Msg_SubmitCheckpointEquivocation_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterOriginDomain",
+			Handler:    _Msg_RegisterOriginDomain_Handler,
+		},
+		{
+			MethodName: "RemoveOriginDomain",
+			Handler:    _Msg_RemoveOriginDomain_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "duty/v1/tx.proto",
+}
+
+func (m *MsgSetDutyMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDutyMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDutyMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Announcements) > 0 {
+		for iNdEx := len(m.Announcements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Announcements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateCheckpointKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateCheckpointKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateCheckpointKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AttestationSignature) > 0 {
+		i -= len(m.AttestationSignature)
+		copy(dAtA[i:], m.AttestationSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AttestationSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewCheckpointPubKey) > 0 {
+		i -= len(m.NewCheckpointPubKey)
+		copy(dAtA[i:], m.NewCheckpointPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewCheckpointPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindCheckpointKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindCheckpointKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindCheckpointKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OriginDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OriginDomain))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ConsensusSignature) > 0 {
+		i -= len(m.ConsensusSignature)
+		copy(dAtA[i:], m.ConsensusSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BindingSignature) > 0 {
+		i -= len(m.BindingSignature)
+		copy(dAtA[i:], m.BindingSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BindingSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CheckpointPubKey) > 0 {
+		i -= len(m.CheckpointPubKey)
+		copy(dAtA[i:], m.CheckpointPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CheckpointPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutyMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutyMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutyMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousKey != nil {
+		{
+			size, err := m.PreviousKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PendingKey != nil {
+		{
+			size, err := m.PendingKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CheckpointKeyValidFrom != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CheckpointKeyValidFrom))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Announcements) > 0 {
+		for iNdEx := len(m.Announcements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Announcements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DomainConfigs) > 0 {
+		for iNdEx := len(m.DomainConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CheckpointStorageUri) > 0 {
+		i -= len(m.CheckpointStorageUri)
+		copy(dAtA[i:], m.CheckpointStorageUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CheckpointStorageUri)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CheckpointPubKey) > 0 {
+		i -= len(m.CheckpointPubKey)
+		copy(dAtA[i:], m.CheckpointPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CheckpointPubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointKeyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointKeyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointKeyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValidUntilHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidUntilHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidFromHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidFromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CheckpointPubKey) > 0 {
+		i -= len(m.CheckpointPubKey)
+		copy(dAtA[i:], m.CheckpointPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CheckpointPubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutyMetadataTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutyMetadataTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutyMetadataTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemovalHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RemovalHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValConsAddr) > 0 {
+		i -= len(m.ValConsAddr)
+		copy(dAtA[i:], m.ValConsAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusKeyMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusKeyMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusKeyMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewConsAddr) > 0 {
+		i -= len(m.NewConsAddr)
+		copy(dAtA[i:], m.NewConsAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewConsAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldConsAddr) > 0 {
+		i -= len(m.OldConsAddr)
+		copy(dAtA[i:], m.OldConsAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitCheckpointSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitCheckpointSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitCheckpointSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MerkleTreeHook) > 0 {
+		i -= len(m.MerkleTreeHook)
+		copy(dAtA[i:], m.MerkleTreeHook)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleTreeHook)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OriginDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OriginDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValConsAddr) > 0 {
+		i -= len(m.ValConsAddr)
+		copy(dAtA[i:], m.ValConsAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LivenessDeadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LivenessDeadline))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.QuorumHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QuorumHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.QuorumReached {
+		i--
+		if m.QuorumReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TotalPower) > 0 {
+		i -= len(m.TotalPower)
+		copy(dAtA[i:], m.TotalPower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TotalPower)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SignedPower) > 0 {
+		i -= len(m.SignedPower)
+		copy(dAtA[i:], m.SignedPower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SignedPower)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MerkleTreeHook) > 0 {
+		i -= len(m.MerkleTreeHook)
+		copy(dAtA[i:], m.MerkleTreeHook)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleTreeHook)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OriginDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OriginDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedCheckpointsCounter != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MissedCheckpointsCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValConsAddr) > 0 {
+		i -= len(m.ValConsAddr)
+		copy(dAtA[i:], m.ValConsAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MerkleTreeHook) > 0 {
+		i -= len(m.MerkleTreeHook)
+		copy(dAtA[i:], m.MerkleTreeHook)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleTreeHook)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OriginDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OriginDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitCheckpointEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitCheckpointEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitCheckpointEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Second.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.First.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterOriginDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterOriginDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterOriginDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterOriginDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterOriginDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterOriginDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOriginDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOriginDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOriginDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DomainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DomainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOriginDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOriginDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOriginDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetDutyMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Announcements) > 0 {
+		for _, e := range m.Announcements {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgRotateCheckpointKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewCheckpointPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AttestationSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgBindCheckpointKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CheckpointPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BindingSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsensusSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.OriginDomain != 0 {
+		n += 1 + sovTx(uint64(m.OriginDomain))
+	}
+	return n
+}
+
+func (m *DutyMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CheckpointPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CheckpointStorageUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DomainConfigs) > 0 {
+		for _, e := range m.DomainConfigs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Announcements) > 0 {
+		for _, e := range m.Announcements {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CheckpointKeyValidFrom != 0 {
+		n += 1 + sovTx(uint64(m.CheckpointKeyValidFrom))
+	}
+	if m.PendingKey != nil {
+		l = m.PendingKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PreviousKey != nil {
+		l = m.PreviousKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointKeyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CheckpointPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValidFromHeight != 0 {
+		n += 1 + sovTx(uint64(m.ValidFromHeight))
+	}
+	if m.ValidUntilHeight != 0 {
+		n += 1 + sovTx(uint64(m.ValidUntilHeight))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *DutyMetadataTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValConsAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RemovalHeight != 0 {
+		n += 1 + sovTx(uint64(m.RemovalHeight))
+	}
+	return n
+}
+
+func (m *ConsensusKeyMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldConsAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewConsAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgSubmitCheckpointSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OriginDomain != 0 {
+		n += 1 + sovTx(uint64(m.OriginDomain))
+	}
+	l = len(m.MerkleTreeHook)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValConsAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *Checkpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OriginDomain != 0 {
+		n += 1 + sovTx(uint64(m.OriginDomain))
+	}
+	l = len(m.MerkleTreeHook)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SignedPower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TotalPower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.QuorumReached {
+		n += 2
+	}
+	if m.QuorumHeight != 0 {
+		n += 1 + sovTx(uint64(m.QuorumHeight))
+	}
+	if m.LivenessDeadline != 0 {
+		n += 1 + sovTx(uint64(m.LivenessDeadline))
+	}
+	return n
+}
+
+func (m *CheckpointSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValConsAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovTx(uint64(m.IndexOffset))
+	}
+	if m.MissedCheckpointsCounter != 0 {
+		n += 1 + sovTx(uint64(m.MissedCheckpointsCounter))
+	}
+	return n
+}
+
+func (m *SignedCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OriginDomain != 0 {
+		n += 1 + sovTx(uint64(m.OriginDomain))
+	}
+	l = len(m.MerkleTreeHook)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitCheckpointEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.First.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Second.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterOriginDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Domain.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterOriginDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveOriginDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DomainId != 0 {
+		n += 1 + sovTx(uint64(m.DomainId))
+	}
+	return n
+}
+
+func (m *MsgRemoveOriginDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetDutyMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDutyMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDutyMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Announcements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Announcements = append(m.Announcements, &ValidatorAnnouncement{})
+			if err := m.Announcements[len(m.Announcements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateCheckpointKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateCheckpointKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateCheckpointKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCheckpointPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCheckpointPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindCheckpointKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindCheckpointKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindCheckpointKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindingSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDomain", wireType)
+			}
+			m.OriginDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutyMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutyMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutyMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointStorageUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointStorageUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainConfigs = append(m.DomainConfigs, &DomainCheckpointConfig{})
+			if err := m.DomainConfigs[len(m.DomainConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Announcements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Announcements = append(m.Announcements, &StorageAnnouncement{})
+			if err := m.Announcements[len(m.Announcements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointKeyValidFrom", wireType)
+			}
+			m.CheckpointKeyValidFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointKeyValidFrom |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingKey == nil {
+				m.PendingKey = &CheckpointKeyRecord{}
+			}
+			if err := m.PendingKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousKey == nil {
+				m.PreviousKey = &CheckpointKeyRecord{}
+			}
+			if err := m.PreviousKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointKeyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointKeyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointKeyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFromHeight", wireType)
+			}
+			m.ValidFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidFromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntilHeight", wireType)
+			}
+			m.ValidUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutyMetadataTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutyMetadataTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutyMetadataTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalHeight", wireType)
+			}
+			m.RemovalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovalHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusKeyMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusKeyMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusKeyMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitCheckpointSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitCheckpointSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitCheckpointSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDomain", wireType)
+			}
+			m.OriginDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Checkpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDomain", wireType)
+			}
+			m.OriginDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, CheckpointSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumReached = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHeight", wireType)
+			}
+			m.QuorumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessDeadline", wireType)
+			}
+			m.LivenessDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCheckpointsCounter", wireType)
+			}
+			m.MissedCheckpointsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCheckpointsCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDomain", wireType)
+			}
+			m.OriginDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitCheckpointEquivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitCheckpointEquivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitCheckpointEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.First.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Second.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterOriginDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterOriginDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterOriginDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterOriginDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterOriginDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterOriginDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveOriginDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOriginDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOriginDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			m.DomainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveOriginDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOriginDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOriginDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)